@@ -41,6 +41,50 @@ type Metrics struct {
 	CgoCall     int64 `json:"cgo_call"`
 	Goroutine   int   `json:"goroutine"`
 	MemoryStats `json:"memory"`
+	Recorder    *RecorderMetrics `json:"recorder,omitempty"`
+	Pools       []PoolMetrics    `json:"pools,omitempty"`
+}
+
+// RecorderMetrics represents the span recorder self-telemetry to be sent to com.insana.plugin.golang
+type RecorderMetrics struct {
+	Recorded            uint64           `json:"recorded"`
+	Evicted             uint64           `json:"evicted"`
+	RejectedBeforeReady uint64           `json:"rejected_before_ready"`
+	Sent                uint64           `json:"sent"`
+	Failed              uint64           `json:"failed"`
+	Dropped             uint64           `json:"dropped"`
+	Queued              int64            `json:"queued"`
+	FlushLatency        HistogramMetrics `json:"flush_latency"`
+	BatchSize           HistogramMetrics `json:"batch_size"`
+}
+
+// PoolMetrics represents the state of a database connection pool to be sent to com.insana.plugin.golang
+type PoolMetrics struct {
+	Type   string `json:"type,omitempty"`
+	Host   string `json:"host,omitempty"`
+	Port   string `json:"port,omitempty"`
+	Schema string `json:"db,omitempty"`
+	User   string `json:"user,omitempty"`
+
+	MaxOpen      int     `json:"max_open,omitempty"`
+	Open         int     `json:"open"`
+	InUse        int     `json:"in_use"`
+	Idle         int     `json:"idle"`
+	WaitCount    int64   `json:"wait_count"`
+	WaitDuration float64 `json:"wait_duration"`
+}
+
+// HistogramMetrics represents a histogram with cumulative buckets
+type HistogramMetrics struct {
+	Count   uint64            `json:"count"`
+	Sum     float64           `json:"sum"`
+	Buckets []HistogramBucket `json:"buckets"`
+}
+
+// HistogramBucket represents a cumulative histogram bucket
+type HistogramBucket struct {
+	UpperBound float64 `json:"le"`
+	Count      uint64  `json:"count"`
 }
 
 // GoProcessData is a representation of a Go process for com.instana.plugin.golang plugin
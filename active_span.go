@@ -0,0 +1,300 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// ActiveSpan is a span started with instana.Start(). Unlike the opentracing.Span, it provides typed setters
+// for the span kind and error recording. All methods are safe to call on a nil *ActiveSpan, as well as on
+// a span returned by instana.Start() when the collector has not been initialized, in which case they do nothing.
+type ActiveSpan struct {
+	span ot.Span
+}
+
+// StartOption configures a span started with instana.Start()
+type StartOption interface {
+	applyStart(cfg *startConfig)
+}
+
+type startConfig struct {
+	tracer      ot.Tracer
+	parent      *SpanContext
+	followsFrom bool
+	kind        SpanKind
+	startTime   time.Time
+	tags        ot.Tags
+	opts        []ot.StartSpanOption
+}
+
+type startOptionFunc func(cfg *startConfig)
+
+func (f startOptionFunc) applyStart(cfg *startConfig) {
+	f(cfg)
+}
+
+// WithTracer returns an option that makes instana.Start() use provided tracer to start the span. By default,
+// the tracer of the parent span found in context is used, falling back to the tracer of the collector initialized
+// with instana.InitCollector().
+func WithTracer(t ot.Tracer) StartOption {
+	return startOptionFunc(func(cfg *startConfig) {
+		cfg.tracer = t
+	})
+}
+
+// WithParent returns an option that makes instana.Start() use provided span context as a parent instead of the
+// span found in context, i.e. a trace context extracted from an incoming message
+func WithParent(sc SpanContext) StartOption {
+	return startOptionFunc(func(cfg *startConfig) {
+		cfg.parent = &sc
+	})
+}
+
+// WithFollowsFrom returns an option that makes the span started with instana.Start() follow from its parent
+// instead of being its child. This relationship is suitable for the asynchronous work whose result the parent
+// span does not depend on.
+func WithFollowsFrom() StartOption {
+	return startOptionFunc(func(cfg *startConfig) {
+		cfg.followsFrom = true
+	})
+}
+
+// WithSpanKind returns an option that sets the kind of the span started with instana.Start(). By default, spans
+// are intermediate.
+func WithSpanKind(kind SpanKind) StartOption {
+	return startOptionFunc(func(cfg *startConfig) {
+		cfg.kind = kind
+	})
+}
+
+// WithTag returns an option that sets a tag on the span started with instana.Start()
+func WithTag(key string, value interface{}) StartOption {
+	return startOptionFunc(func(cfg *startConfig) {
+		if cfg.tags == nil {
+			cfg.tags = make(ot.Tags)
+		}
+
+		cfg.tags[key] = value
+	})
+}
+
+// WithStartTime returns an option that sets an explicit start time of the span started with instana.Start()
+func WithStartTime(t time.Time) StartOption {
+	return startOptionFunc(func(cfg *startConfig) {
+		cfg.startTime = t
+	})
+}
+
+// WithStartSpanOptions returns an option that passes provided opentracing.StartSpanOption values to the tracer,
+// so that the existing options, i.e. instana.BatchSize() or instana.SuppressTracing(), can be used with instana.Start()
+func WithStartSpanOptions(opts ...ot.StartSpanOption) StartOption {
+	return startOptionFunc(func(cfg *startConfig) {
+		cfg.opts = append(cfg.opts, opts...)
+	})
+}
+
+func (l SpanLinks) applyStart(cfg *startConfig) {
+	cfg.opts = append(cfg.opts, l)
+}
+
+// Start starts a new span with provided name and returns it along with the context holding a reference to it.
+// If the context already holds a span, the new span becomes its child:
+//
+//	ctx, sp := instana.Start(ctx, "process-order", instana.WithSpanKind(instana.EntrySpanKind))
+//	defer sp.Finish()
+//
+//	if err := process(ctx, order); err != nil {
+//		sp.RecordError(err)
+//	}
+//
+// The span is stored in the returned context as an opentracing.Span, so it is available to the instrumentation
+// packages that use instana.SpanFromContext().
+func Start(ctx context.Context, name string, opts ...StartOption) (context.Context, *ActiveSpan) {
+	var cfg startConfig
+	for _, opt := range opts {
+		opt.applyStart(&cfg)
+	}
+
+	var sso []ot.StartSpanOption
+
+	parent, hasParent := SpanFromContext(ctx)
+
+	reference := ot.ChildOf
+	if cfg.followsFrom {
+		reference = ot.FollowsFrom
+	}
+
+	switch {
+	case cfg.parent != nil:
+		sso = append(sso, reference(*cfg.parent))
+	case hasParent:
+		sso = append(sso, reference(parent.Context()))
+	}
+
+	if !cfg.startTime.IsZero() {
+		sso = append(sso, ot.StartTime(cfg.startTime))
+	}
+
+	if cfg.kind != 0 {
+		sso = append(sso, ot.Tag{Key: string(ext.SpanKind), Value: cfg.kind.String()})
+	}
+
+	if len(cfg.tags) > 0 {
+		sso = append(sso, cfg.tags)
+	}
+
+	sso = append(sso, cfg.opts...)
+
+	tracer := cfg.tracer
+	if tracer == nil && hasParent {
+		tracer = parent.Tracer()
+	}
+
+	if tracer == nil {
+		tracer, _ = GetCollector()
+	}
+
+	sp := tracer.StartSpan(name, sso...)
+	if sp == nil {
+		return ctx, &ActiveSpan{}
+	}
+
+	return ContextWithSpan(ctx, sp), &ActiveSpan{span: sp}
+}
+
+// ActiveSpanFromContext retrieves previously stored active span from context. If there is no
+// span, this method returns a no-op span and false.
+func ActiveSpanFromContext(ctx context.Context) (*ActiveSpan, bool) {
+	sp, ok := SpanFromContext(ctx)
+	if !ok {
+		return &ActiveSpan{}, false
+	}
+
+	return &ActiveSpan{span: sp}, true
+}
+
+// Context returns the span context. If the span has not been started by the Instana tracer,
+// an empty span context is returned.
+func (s *ActiveSpan) Context() SpanContext {
+	if s == nil || s.span == nil {
+		return SpanContext{}
+	}
+
+	sc, _ := s.span.Context().(SpanContext)
+
+	return sc
+}
+
+// SetOperationName changes the name of the span
+func (s *ActiveSpan) SetOperationName(name string) *ActiveSpan {
+	if s == nil || s.span == nil {
+		return s
+	}
+
+	s.span.SetOperationName(name)
+
+	return s
+}
+
+// SetTag sets a tag on the span
+func (s *ActiveSpan) SetTag(key string, value interface{}) *ActiveSpan {
+	if s == nil || s.span == nil {
+		return s
+	}
+
+	s.span.SetTag(key, value)
+
+	return s
+}
+
+// SetKind sets the kind of the span
+func (s *ActiveSpan) SetKind(kind SpanKind) *ActiveSpan {
+	return s.SetTag(string(ext.SpanKind), kind.String())
+}
+
+// SetBaggageItem sets a baggage item that is propagated to all descendants of the span
+func (s *ActiveSpan) SetBaggageItem(key, val string) *ActiveSpan {
+	if s == nil || s.span == nil {
+		return s
+	}
+
+	s.span.SetBaggageItem(key, val)
+
+	return s
+}
+
+// BaggageItem returns the value of a baggage item
+func (s *ActiveSpan) BaggageItem(key string) string {
+	if s == nil || s.span == nil {
+		return ""
+	}
+
+	return s.span.BaggageItem(key)
+}
+
+// RecordError marks the span as erroneous by incrementing its error count and logs provided error. The call
+// stack of the caller is attached to the span, unless another error has already been recorded. Nil errors
+// are ignored.
+func (s *ActiveSpan) RecordError(err error) *ActiveSpan {
+	if s == nil || s.span == nil || err == nil {
+		return s
+	}
+
+	s.recordError(err, captureStack(1))
+
+	return s
+}
+
+func (s *ActiveSpan) recordError(err error, stack []StackFrame) {
+	if sp, ok := s.span.(*spanS); ok {
+		sp.recordError(err, stack)
+		return
+	}
+
+	s.span.LogFields(otlog.Error(err), otlog.String("stack", formatStack(stack)))
+}
+
+// Finish finishes the span
+func (s *ActiveSpan) Finish() {
+	if s == nil || s.span == nil {
+		return
+	}
+
+	s.span.Finish()
+}
+
+// FinishAt finishes the span using provided time as the end timestamp
+func (s *ActiveSpan) FinishAt(t time.Time) {
+	if s == nil || s.span == nil {
+		return
+	}
+
+	s.span.FinishWithOptions(ot.FinishOptions{FinishTime: t})
+}
+
+// OpenTracingSpan returns the underlying opentracing.Span to be used with the APIs that have not been
+// migrated yet. It returns nil if the span is a no-op.
+func (s *ActiveSpan) OpenTracingSpan() ot.Span {
+	if s == nil {
+		return nil
+	}
+
+	return s.span
+}
+
+func formatStack(stack []StackFrame) string {
+	var sb strings.Builder
+	for _, fr := range stack {
+		fmt.Fprintf(&sb, "%s\n\t%s:%d\n", fr.Method, fr.File, fr.Line)
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,220 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStart(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	start := time.Now().Add(-time.Second)
+
+	ctx, entry := instana.Start(context.Background(), "entry",
+		instana.WithSpanKind(instana.EntrySpanKind),
+		instana.WithStartTime(start),
+		instana.WithTag("key", "value"),
+	)
+	entry.SetBaggageItem("foo", "bar")
+
+	childCtx, child := instana.Start(ctx, "child")
+	child.SetKind(instana.ExitSpanKind).SetTag("attempt", 1)
+
+	sp, ok := instana.ActiveSpanFromContext(childCtx)
+	require.True(t, ok)
+	assert.Equal(t, child.Context(), sp.Context())
+	assert.Equal(t, "bar", sp.BaggageItem("foo"))
+
+	child.Finish()
+	entry.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	childSpan, entrySpan := spans[0], spans[1]
+
+	assert.Equal(t, entry.Context().TraceID, entrySpan.TraceID)
+	assert.Equal(t, entry.Context().SpanID, entrySpan.SpanID)
+	assert.Empty(t, entrySpan.ParentID)
+	assert.Equal(t, int(instana.EntrySpanKind), entrySpan.Kind)
+	assert.Equal(t, uint64(start.UnixNano())/uint64(time.Millisecond), entrySpan.Timestamp)
+
+	require.IsType(t, instana.SDKSpanData{}, entrySpan.Data)
+	entryData := entrySpan.Data.(instana.SDKSpanData)
+	assert.Equal(t, "entry", entryData.Tags.Name)
+	assert.Equal(t, "value", entryData.Tags.Custom["tags"].(ot.Tags)["key"])
+
+	assert.Equal(t, entrySpan.TraceID, childSpan.TraceID)
+	assert.Equal(t, entrySpan.SpanID, childSpan.ParentID)
+	assert.Equal(t, int(instana.ExitSpanKind), childSpan.Kind)
+
+	require.IsType(t, instana.SDKSpanData{}, childSpan.Data)
+	childData := childSpan.Data.(instana.SDKSpanData)
+	assert.Equal(t, "child", childData.Tags.Name)
+	assert.Equal(t, 1, childData.Tags.Custom["tags"].(ot.Tags)["attempt"])
+}
+
+func TestStart_WithParent(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	ctx, sp := instana.Start(context.Background(), "root", instana.WithSpanKind(instana.EntrySpanKind))
+	defer sp.Finish()
+
+	parent := instana.SpanContext{TraceIDHi: 0x1, TraceID: 0x2435, SpanID: 0x3546}
+
+	_, consumer := instana.Start(ctx, "consumer",
+		instana.WithParent(parent),
+		instana.WithSpanKind(instana.EntrySpanKind),
+	)
+	consumer.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, parent.TraceIDHi, spans[0].TraceIDHi)
+	assert.Equal(t, parent.TraceID, spans[0].TraceID)
+	assert.Equal(t, parent.SpanID, spans[0].ParentID)
+}
+
+func TestStart_WithTracer(t *testing.T) {
+	tracer := mocktracer.New()
+
+	ctx, sp := instana.Start(context.Background(), "entry",
+		instana.WithTracer(tracer),
+		instana.WithSpanKind(instana.EntrySpanKind),
+	)
+
+	// child spans are started by the tracer of the parent
+	_, child := instana.Start(ctx, "child")
+	child.RecordError(errors.New("something went wrong"))
+	child.Finish()
+	sp.Finish()
+
+	spans := tracer.FinishedSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "child", spans[0].OperationName)
+	assert.Equal(t, spans[1].SpanContext.SpanID, spans[0].ParentID)
+
+	assert.Equal(t, "entry", spans[1].OperationName)
+	assert.Equal(t, "entry", spans[1].Tag(string(ext.SpanKind)))
+
+	logs := spans[0].Logs()
+	require.Len(t, logs, 1)
+	require.Len(t, logs[0].Fields, 2)
+
+	assert.Equal(t, "error.object", logs[0].Fields[0].Key)
+	assert.Equal(t, "something went wrong", logs[0].Fields[0].ValueString)
+	assert.Equal(t, "stack", logs[0].Fields[1].Key)
+	assert.Contains(t, logs[0].Fields[1].ValueString, "TestStart_WithTracer")
+
+	assert.Zero(t, child.Context())
+}
+
+func TestStart_CollectorNotInitialized(t *testing.T) {
+	instana.ShutdownCollector()
+
+	ctx := context.Background()
+
+	spCtx, sp := instana.Start(ctx, "entry", instana.WithSpanKind(instana.EntrySpanKind))
+	require.NotNil(t, sp)
+	assert.Equal(t, ctx, spCtx)
+
+	assert.NotPanics(t, func() {
+		sp.SetTag("key", "value").
+			SetKind(instana.ExitSpanKind).
+			SetOperationName("exit").
+			SetBaggageItem("foo", "bar").
+			RecordError(errors.New("something went wrong"))
+
+		sp.Finish()
+	})
+
+	assert.Nil(t, sp.OpenTracingSpan())
+	assert.Zero(t, sp.Context())
+	assert.Empty(t, sp.BaggageItem("foo"))
+
+	_, ok := instana.ActiveSpanFromContext(spCtx)
+	assert.False(t, ok)
+}
+
+func TestActiveSpan_Nil(t *testing.T) {
+	var sp *instana.ActiveSpan
+
+	assert.NotPanics(t, func() {
+		sp.SetTag("key", "value").
+			SetKind(instana.EntrySpanKind).
+			RecordError(errors.New("something went wrong")).
+			FinishAt(time.Now())
+	})
+}
+
+func TestActiveSpan_RecordError(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	_, sp := instana.Start(context.Background(), "entry", instana.WithSpanKind(instana.EntrySpanKind))
+	sp.RecordError(nil)
+	sp.RecordError(errors.New("first error"))
+	sp.RecordError(errors.New("second error"))
+	sp.Finish()
+
+	var entrySpan instana.Span
+
+	spans := recorder.GetQueuedSpans()
+	for _, span := range spans {
+		if span.Name == string(instana.SDKSpanType) {
+			entrySpan = span
+		}
+	}
+
+	assert.Equal(t, 2, entrySpan.Ec)
+
+	require.NotEmpty(t, entrySpan.Stack)
+	assert.Equal(t, "github.com/instana/go-sensor_test.TestActiveSpan_RecordError", entrySpan.Stack[0].Method)
+	assert.Contains(t, entrySpan.Stack[0].File, "active_span_test.go")
+	assert.NotZero(t, entrySpan.Stack[0].Line)
+
+	data, err := json.Marshal(entrySpan)
+	require.NoError(t, err)
+
+	var doc struct {
+		Ec    int `json:"ec"`
+		Stack []struct {
+			File   string `json:"c"`
+			Line   int    `json:"n"`
+			Method string `json:"m"`
+		} `json:"stack"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	assert.Equal(t, 2, doc.Ec)
+	require.Len(t, doc.Stack, len(entrySpan.Stack))
+	assert.Equal(t, entrySpan.Stack[0].Method, doc.Stack[0].Method)
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/opentracing/opentracing-go"
 	ot "github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
-	otlog "github.com/opentracing/opentracing-go/log"
 )
 
 var _ TracerLogger = (*Sensor)(nil)
@@ -155,11 +154,7 @@ func (s *Sensor) WithTracingSpan(operationName string, w http.ResponseWriter, re
 
 		// Be sure to capture any kind of panic / error
 		if err := recover(); err != nil {
-			if e, ok := err.(error); ok {
-				span.LogFields(otlog.Error(e))
-			} else {
-				span.LogFields(otlog.Object("error", err))
-			}
+			RecordPanic(span, err, "")
 
 			// re-throw the panic
 			panic(err)
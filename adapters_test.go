@@ -5,6 +5,7 @@ package instana_test
 
 import (
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	instana "github.com/instana/go-sensor"
@@ -102,10 +103,13 @@ func TestWithTracingSpan_PanicHandling(t *testing.T) {
 	require.IsType(t, instana.LogSpanData{}, logSpan.Data)
 	logData := logSpan.Data.(instana.LogSpanData)
 
-	assert.Equal(t, instana.LogSpanTags{
-		Level:   "ERROR",
-		Message: `error: "something went wrong"`,
-	}, logData.Tags)
+	assert.Equal(t, "ERROR", logData.Tags.Level)
+	assert.True(t, strings.HasPrefix(logData.Tags.Message,
+		`error: "something went wrong" panic.type: "string" stack: "github.com/instana/go-sensor_test.TestWithTracingSpan_PanicHandling.func1.1\n\t`),
+		logData.Tags.Message)
+
+	require.NotEmpty(t, span.Stack)
+	assert.Equal(t, "github.com/instana/go-sensor_test.TestWithTracingSpan_PanicHandling.func1.1", span.Stack[0].Method)
 }
 
 func TestWithTracingSpan_WithActiveParentSpan(t *testing.T) {
@@ -49,6 +49,10 @@ type agentResponse struct {
 		Matcher string   `json:"matcher"`
 		List    []string `json:"list"`
 	} `json:"secrets"`
+	SecretValues struct {
+		Patterns []string `json:"patterns"`
+		Regex    []string `json:"regex"`
+	} `json:"secret-values"`
 	ExtraHTTPHeaders []string `json:"extraHeaders"`
 	Tracing          struct {
 		ExtraHTTPHeaders []string          `json:"extra-http-headers"`
@@ -109,6 +113,7 @@ type agentS struct {
 
 	snapshot *SnapshotCollector
 	logger   LeveledLogger
+	spool    *spool
 
 	printPayloadTooLargeErrInfoOnce sync.Once
 }
@@ -187,6 +192,10 @@ func (agent *agentS) SendEvent(event *EventData) error {
 		// do not reset the agent as it might be not initialized at this state yet
 		agent.logger.Warn("failed to send event ", eventTitle, " to the host agent: ", err)
 
+		if err != payloadTooLargeErr && agent.spoolData(spoolEvent, event) {
+			return nil
+		}
+
 		return err
 	}
 
@@ -202,6 +211,7 @@ func (agent *agentS) SendSpans(spans []Span) error {
 	err := agent.agentComm.sendDataToAgent(agentTracesURL, spans)
 	if err != nil {
 		if err == payloadTooLargeErr {
+			recorderStats.dropped.Add(uint64(len(spans)))
 			agent.printPayloadTooLargeErrInfoOnce.Do(
 				func() {
 					agent.logDetailedInformationAboutDroppedSpans(numberOfBigSpansToLog, spans, err)
@@ -214,6 +224,10 @@ func (agent *agentS) SendSpans(spans []Span) error {
 			agent.reset()
 		}
 
+		if agent.spoolData(spoolSpans, spans) {
+			return nil
+		}
+
 		return err
 	}
 
@@ -244,6 +258,10 @@ func (agent *agentS) SendProfiles(profiles []autoprofile.Profile) error {
 		agent.logger.Error("failed to send profile data to the host agent: ", err)
 		agent.reset()
 
+		if err != payloadTooLargeErr && agent.spoolData(spoolProfiles, profiles) {
+			return nil
+		}
+
 		return err
 	}
 
@@ -254,6 +272,103 @@ func (agent *agentS) setLogger(l LeveledLogger) {
 	agent.logger = l
 }
 
+func (agent *agentS) setSpool(sp *spool) {
+	agent.mu.Lock()
+	defer agent.mu.Unlock()
+
+	agent.spool = sp
+}
+
+func (agent *agentS) getSpool() *spool {
+	agent.mu.RLock()
+	defer agent.mu.RUnlock()
+
+	return agent.spool
+}
+
+// spoolData stores the data that could not be sent to the agent in the disk spool. It returns false
+// if the spool is disabled or the data could not be written.
+func (agent *agentS) spoolData(kind spoolRecordKind, payload interface{}) bool {
+	sp := agent.getSpool()
+	if sp == nil {
+		return false
+	}
+
+	if err := sp.Append(kind, payload); err != nil {
+		agent.logger.Warn("failed to spool the data that could not be sent to the host agent: ", err)
+		return false
+	}
+
+	agent.logger.Debug("the data that could not be sent to the host agent has been spooled")
+
+	return true
+}
+
+// replaySpool sends the spooled data to the agent in the order it has been written. The replay stops
+// at the first failure, leaving the rest of the data in the spool until the agent is ready again.
+func (agent *agentS) replaySpool() {
+	sp := agent.getSpool()
+	if sp == nil {
+		return
+	}
+
+	err := sp.Replay(func(rec spoolRecord) error {
+		err := agent.sendSpoolRecord(rec)
+		if err == payloadTooLargeErr {
+			agent.logger.Warn("discarding spooled data that is too large to be sent to the host agent")
+			return nil
+		}
+
+		return err
+	})
+
+	if err != nil {
+		agent.logger.Error("failed to send spooled data to the host agent: ", err)
+		agent.reset()
+	}
+}
+
+func (agent *agentS) sendSpoolRecord(rec spoolRecord) error {
+	switch rec.Kind {
+	case spoolSpans:
+		var spans []map[string]json.RawMessage
+		if err := json.Unmarshal(rec.Payload, &spans); err != nil {
+			agent.logger.Debug("discarding malformed spooled spans: ", err)
+			return nil
+		}
+
+		// the spans might have been spooled by another process, so the source needs to be updated
+		from, err := json.Marshal(agent.agentComm.from)
+		if err != nil {
+			return err
+		}
+
+		for _, sp := range spans {
+			sp["f"] = from
+		}
+
+		return agent.agentComm.sendDataToAgent(agentTracesURL, spans)
+	case spoolProfiles:
+		var profiles []autoprofile.Profile
+		if err := json.Unmarshal(rec.Payload, &profiles); err != nil {
+			agent.logger.Debug("discarding malformed spooled profiles: ", err)
+			return nil
+		}
+
+		agentProfiles := make([]hostAgentProfile, 0, len(profiles))
+		for _, p := range profiles {
+			agentProfiles = append(agentProfiles, hostAgentProfile{p, agent.agentComm.from.EntityID})
+		}
+
+		return agent.agentComm.sendDataToAgent(agentProfilesURL, agentProfiles)
+	case spoolEvent:
+		return agent.agentComm.sendDataToAgent(agentEventURL, rec.Payload)
+	default:
+		agent.logger.Debug("discarding spooled record of unknown kind ", rec.Kind)
+		return nil
+	}
+}
+
 func (agent *agentS) reset() {
 	agent.mu.Lock()
 	agent.fsm.reset()
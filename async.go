@@ -0,0 +1,123 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"sync"
+)
+
+// Go runs fn in a new goroutine within an intermediate span that follows from the span found in ctx. The context
+// passed to fn holds a reference to the new span. If fn panics, the panic is recorded as an error of the span, which
+// is then finished before the panic is propagated further.
+//
+//	instana.Go(ctx, "send-notification", func(ctx context.Context) {
+//		notify(ctx, user)
+//	})
+func Go(ctx context.Context, name string, fn func(ctx context.Context)) {
+	go runAsync(ctx, name, func(ctx context.Context) error {
+		fn(ctx)
+		return nil
+	})
+}
+
+// WrapTask returns a function that runs fn within an intermediate span that follows from the span found in ctx.
+// The span context is captured when WrapTask is called, so that the returned function can be submitted to a worker
+// pool and executed later by any of its goroutines:
+//
+//	pool.Submit(instana.WrapTask(ctx, "resize-image", func(ctx context.Context) {
+//		resize(ctx, img)
+//	}))
+//
+// The span is started when the task is executed. Panics are handled the same way as by instana.Go().
+func WrapTask(ctx context.Context, name string, fn func(ctx context.Context)) func() {
+	return func() {
+		runAsync(ctx, name, func(ctx context.Context) error {
+			fn(ctx)
+			return nil
+		})
+	}
+}
+
+// Group is a collection of goroutines working on subtasks of the same task, each of them traced with an intermediate
+// span that follows from the span found in the group context. It provides the same semantics as
+// golang.org/x/sync/errgroup.Group. A zero Group is valid and does not cancel on error.
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	wg sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+// GroupWithContext returns a new Group and an associated context derived from ctx. The derived context is canceled
+// the first time a function passed to Go returns a non-nil error or the first time Wait returns, whichever
+// occurs first.
+func GroupWithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	return &Group{ctx: ctx, cancel: cancel}, ctx
+}
+
+// Go calls fn in a new goroutine within a span with provided name. The context passed to fn is derived from the
+// group context and holds a reference to the new span. An error returned by fn is recorded to the span. The first
+// call to return a non-nil error cancels the group context, and its error is returned by Wait.
+func (g *Group) Go(name string, fn func(ctx context.Context) error) {
+	ctx := g.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	g.wg.Add(1)
+
+	go func() {
+		defer g.wg.Done()
+
+		if err := runAsync(ctx, name, fn); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				if g.cancel != nil {
+					g.cancel(g.err)
+				}
+			})
+		}
+	}()
+}
+
+// Wait blocks until all function calls from the Go method have returned, then returns the first non-nil
+// error (if any) from them
+func (g *Group) Wait() error {
+	g.wg.Wait()
+
+	if g.cancel != nil {
+		g.cancel(g.err)
+	}
+
+	return g.err
+}
+
+// runAsync calls fn within an intermediate span that follows from the span found in ctx. The returned error and
+// a panic, if any, are recorded to the span.
+func runAsync(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	ctx, sp := Start(ctx, name, WithFollowsFrom(), WithSpanKind(IntermediateSpanKind))
+
+	defer func() {
+		if p := recover(); p != nil {
+			RecordPanic(sp.OpenTracingSpan(), p, "")
+			sp.Finish()
+
+			panic(p)
+		}
+
+		sp.Finish()
+	}()
+
+	err := fn(ctx)
+	if err != nil {
+		sp.RecordError(err)
+	}
+
+	return err
+}
@@ -0,0 +1,225 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGo(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	ctx, entry := instana.Start(context.Background(), "entry", instana.WithSpanKind(instana.EntrySpanKind))
+
+	done := make(chan instana.SpanContext)
+	instana.Go(ctx, "async", func(ctx context.Context) {
+		sp, ok := instana.ActiveSpanFromContext(ctx)
+		assert.True(t, ok)
+
+		done <- sp.Context()
+	})
+
+	asyncCtx := <-done
+	entry.Finish()
+
+	require.Eventually(t, func() bool {
+		return recorder.QueuedSpansCount() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	spans := recorder.GetQueuedSpans()
+
+	var asyncSpan instana.Span
+	for _, sp := range spans {
+		if sp.SpanID == asyncCtx.SpanID {
+			asyncSpan = sp
+		}
+	}
+
+	assert.Equal(t, entry.Context().TraceID, asyncSpan.TraceID)
+	assert.Equal(t, entry.Context().SpanID, asyncSpan.ParentID)
+	assert.Equal(t, int(instana.IntermediateSpanKind), asyncSpan.Kind)
+	assert.True(t, asyncSpan.FollowsFrom)
+	assert.Zero(t, asyncSpan.Ec)
+
+	data, err := json.Marshal(asyncSpan)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"ff":true`)
+}
+
+func TestWrapTask(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	ctx, entry := instana.Start(context.Background(), "entry", instana.WithSpanKind(instana.EntrySpanKind))
+
+	var taskCtx instana.SpanContext
+	task := instana.WrapTask(ctx, "task", func(ctx context.Context) {
+		sp, _ := instana.ActiveSpanFromContext(ctx)
+		taskCtx = sp.Context()
+	})
+
+	// the parent span is finished before the task is executed by a worker
+	entry.Finish()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		task()
+	}()
+	wg.Wait()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, taskCtx.SpanID, spans[1].SpanID)
+	assert.Equal(t, entry.Context().SpanID, spans[1].ParentID)
+	assert.True(t, spans[1].FollowsFrom)
+}
+
+func TestWrapTask_Panic(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	ctx, entry := instana.Start(context.Background(), "entry", instana.WithSpanKind(instana.EntrySpanKind))
+	defer entry.Finish()
+
+	task := instana.WrapTask(ctx, "task", func(ctx context.Context) {
+		panic("something went wrong")
+	})
+
+	assert.PanicsWithValue(t, "something went wrong", task)
+
+	var taskSpan instana.Span
+	for _, sp := range recorder.GetQueuedSpans() {
+		if sp.Name == string(instana.SDKSpanType) {
+			taskSpan = sp
+		}
+	}
+
+	assert.Equal(t, entry.Context().SpanID, taskSpan.ParentID)
+	assert.Equal(t, 1, taskSpan.Ec)
+
+	require.NotEmpty(t, taskSpan.Stack)
+	assert.Equal(t, "github.com/instana/go-sensor_test.TestWrapTask_Panic.func1", taskSpan.Stack[0].Method)
+}
+
+func TestGroup(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	ctx, entry := instana.Start(context.Background(), "entry", instana.WithSpanKind(instana.EntrySpanKind))
+
+	expectedErr := errors.New("something went wrong")
+
+	g, gCtx := instana.GroupWithContext(ctx)
+	g.Go("failing", func(ctx context.Context) error {
+		return expectedErr
+	})
+	g.Go("canceled", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	assert.Same(t, expectedErr, g.Wait())
+	assert.Same(t, expectedErr, context.Cause(gCtx))
+
+	entry.Finish()
+
+	spans := recorder.GetQueuedSpans()
+
+	errorCounts := make(map[string]int)
+	for _, sp := range spans {
+		if sp.ParentID != entry.Context().SpanID {
+			continue
+		}
+
+		assert.True(t, sp.FollowsFrom)
+		assert.Equal(t, entry.Context().TraceID, sp.TraceID)
+
+		data := sp.Data.(instana.SDKSpanData)
+		errorCounts[data.Tags.Name] = sp.Ec
+	}
+
+	assert.Equal(t, map[string]int{
+		"failing":  1,
+		"canceled": 0,
+	}, errorCounts)
+}
+
+func TestGroup_Zero(t *testing.T) {
+	var (
+		g     instana.Group
+		mu    sync.Mutex
+		calls int
+	)
+
+	for i := 0; i < 3; i++ {
+		g.Go("task", func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			calls++
+
+			return nil
+		})
+	}
+
+	assert.NoError(t, g.Wait())
+	assert.Equal(t, 3, calls)
+}
+
+func TestTracer_StartSpan_FollowsFrom(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	entry := c.StartSpan("entry", ext.SpanKindRPCServer)
+	sp := c.StartSpan("async", ot.FollowsFrom(entry.Context()))
+	sp.Finish()
+
+	child := c.StartSpan("child", ot.ChildOf(entry.Context()))
+	child.Finish()
+
+	entry.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 3)
+
+	assert.Equal(t, spans[2].SpanID, spans[0].ParentID)
+	assert.True(t, spans[0].FollowsFrom)
+
+	assert.Equal(t, spans[2].SpanID, spans[1].ParentID)
+	assert.False(t, spans[1].FollowsFrom)
+}
@@ -0,0 +1,403 @@
+// (c) Copyright IBM Corp. 2026
+
+// Package baggage implements parsing and formatting of the W3C Baggage header as defined by
+// https://www.w3.org/TR/baggage/
+package baggage
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	// Header is the W3C Baggage header name as defined by https://www.w3.org/TR/baggage/
+	Header = "baggage"
+
+	// MaxLength is the maximum length of the `baggage` header value in bytes
+	MaxLength = 8192
+	// MaxMembers is the maximum number of list members in the `baggage` header
+	MaxMembers = 180
+	// MaxMemberLength is the maximum length of a single list member including its properties in bytes
+	MaxMemberLength = 4096
+)
+
+// ErrBaggageNotFound is an error returned by baggage.Extract() if provided HTTP headers do not contain
+// the `baggage` header
+var ErrBaggageNotFound = errors.New("no w3c baggage")
+
+// Property is an optional metadata entry attached to a baggage list member. A property might be
+// either a key-value pair or a key only.
+type Property struct {
+	Key      string
+	Value    string
+	HasValue bool
+}
+
+// Member is a baggage list member. The value is stored decoded.
+type Member struct {
+	Key        string
+	Value      string
+	Properties []Property
+}
+
+// String returns the percent-encoded representation of a list member compatible with the `baggage` header
+// format. The returned value is empty if the member key is not a valid token.
+func (m Member) String() string {
+	if !isToken(m.Key) {
+		return ""
+	}
+
+	var buf strings.Builder
+	buf.WriteString(m.Key)
+	buf.WriteByte('=')
+	buf.WriteString(encodeValue(m.Value))
+
+	for _, p := range m.Properties {
+		if !isToken(p.Key) {
+			continue
+		}
+
+		buf.WriteByte(';')
+		buf.WriteString(p.Key)
+
+		if p.HasValue {
+			buf.WriteByte('=')
+			buf.WriteString(encodeValue(p.Value))
+		}
+	}
+
+	return buf.String()
+}
+
+// Baggage is an ordered list of baggage members with unique keys
+type Baggage struct {
+	members []Member
+}
+
+// New returns a new Baggage containing provided members. If there are several members with the same key,
+// the last one wins.
+func New(members ...Member) Baggage {
+	var b Baggage
+	for _, m := range members {
+		b = b.Set(m)
+	}
+
+	return b
+}
+
+// FromMap returns a new Baggage populated with the key-value pairs from the map. Since the map
+// iteration order is random, the members are sorted by key.
+func FromMap(m map[string]string) Baggage {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b Baggage
+	for _, k := range keys {
+		b = b.Set(Member{Key: k, Value: m[k]})
+	}
+
+	return b
+}
+
+// Parse parses the value of `baggage` header. Malformed list members are discarded, as well as the members
+// exceeding the size limits.
+func Parse(s string) Baggage {
+	var (
+		b   Baggage
+		ln  int
+		raw = strings.Split(s, ",")
+	)
+
+	for _, item := range raw {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if len(item) > MaxMemberLength || len(b.members) == MaxMembers {
+			continue
+		}
+
+		m, ok := parseMember(item)
+		if !ok {
+			continue
+		}
+
+		// account for the comma separator
+		itemLen := len(item)
+		if ln > 0 {
+			itemLen++
+		}
+
+		if ln+itemLen > MaxLength {
+			continue
+		}
+		ln += itemLen
+
+		b = b.Set(m)
+	}
+
+	return b
+}
+
+// Extract extracts the W3C baggage from HTTP headers. Multiple `baggage` headers are combined into one list.
+// Returns ErrBaggageNotFound if provided value doesn't contain the `baggage` header.
+func Extract(headers http.Header) (Baggage, error) {
+	var values []string
+	for k, v := range headers {
+		if strings.EqualFold(k, Header) {
+			values = append(values, v...)
+		}
+	}
+
+	if len(values) == 0 {
+		return Baggage{}, ErrBaggageNotFound
+	}
+
+	return Parse(strings.Join(values, ",")), nil
+}
+
+// Inject sets the `baggage` header, overriding any previously set values. The header is removed
+// if the baggage is empty.
+func Inject(b Baggage, headers http.Header) {
+	// delete existing headers ignoring the header name case
+	for k := range headers {
+		if strings.EqualFold(k, Header) {
+			delete(headers, k)
+		}
+	}
+
+	if s := b.String(); s != "" {
+		headers.Set(Header, s)
+	}
+}
+
+// Len returns the number of members in baggage
+func (b Baggage) Len() int {
+	return len(b.members)
+}
+
+// Members returns a copy of the baggage list members
+func (b Baggage) Members() []Member {
+	return append([]Member(nil), b.members...)
+}
+
+// Member returns the list member with given key
+func (b Baggage) Member(key string) (Member, bool) {
+	if i := b.indexOf(key); i >= 0 {
+		return b.members[i], true
+	}
+
+	return Member{}, false
+}
+
+// Set returns a copy of baggage with the member added. A member with the same key is replaced
+// in place, otherwise the new member is appended to the end of the list.
+func (b Baggage) Set(m Member) Baggage {
+	members := make([]Member, len(b.members), len(b.members)+1)
+	copy(members, b.members)
+
+	if i := b.indexOf(m.Key); i >= 0 {
+		members[i] = m
+	} else {
+		members = append(members, m)
+	}
+
+	return Baggage{members: members}
+}
+
+// SetValue returns a copy of baggage with the value of a member with given key set. The properties
+// of an existing member are preserved.
+func (b Baggage) SetValue(key, value string) Baggage {
+	m, _ := b.Member(key)
+	m.Key, m.Value = key, value
+
+	return b.Set(m)
+}
+
+// Delete returns a copy of baggage without the member with given key
+func (b Baggage) Delete(key string) Baggage {
+	i := b.indexOf(key)
+	if i < 0 {
+		return b
+	}
+
+	members := make([]Member, 0, len(b.members)-1)
+	members = append(members, b.members[:i]...)
+	members = append(members, b.members[i+1:]...)
+
+	return Baggage{members: members}
+}
+
+// Map returns the baggage key-value pairs discarding the member properties
+func (b Baggage) Map() map[string]string {
+	m := make(map[string]string, len(b.members))
+	for _, mb := range b.members {
+		m[mb.Key] = mb.Value
+	}
+
+	return m
+}
+
+// String returns string representation of baggage compatible with the `baggage` header format. Members with
+// invalid keys are omitted. Members that exceed the size limits are dropped.
+func (b Baggage) String() string {
+	var (
+		buf strings.Builder
+		n   int
+	)
+
+	for _, m := range b.members {
+		if n == MaxMembers {
+			break
+		}
+
+		s := m.String()
+		if s == "" || len(s) > MaxMemberLength {
+			continue
+		}
+
+		ln := len(s)
+		if buf.Len() > 0 {
+			ln++
+		}
+
+		if buf.Len()+ln > MaxLength {
+			continue
+		}
+
+		if buf.Len() > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(s)
+		n++
+	}
+
+	return buf.String()
+}
+
+func (b Baggage) indexOf(key string) int {
+	for i, m := range b.members {
+		if m.Key == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// parseMember parses a list member in form of `key=value;prop1=value1;prop2`
+func parseMember(s string) (Member, bool) {
+	parts := strings.Split(s, ";")
+
+	key, value, ok := parseKeyValue(parts[0], true)
+	if !ok {
+		return Member{}, false
+	}
+
+	m := Member{Key: key, Value: value}
+
+	for _, p := range parts[1:] {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+
+		var prop Property
+		prop.Key, prop.Value, ok = parseKeyValue(p, false)
+		if !ok {
+			return Member{}, false
+		}
+		prop.HasValue = strings.Contains(p, "=")
+
+		m.Properties = append(m.Properties, prop)
+	}
+
+	return m, true
+}
+
+func parseKeyValue(s string, valueRequired bool) (string, string, bool) {
+	key, value, found := strings.Cut(s, "=")
+	if !found && valueRequired {
+		return "", "", false
+	}
+
+	key = strings.TrimSpace(key)
+	if !isToken(key) {
+		return "", "", false
+	}
+
+	if !found {
+		return key, "", true
+	}
+
+	value = strings.TrimSpace(value)
+	for i := 0; i < len(value); i++ {
+		if !isBaggageOctet(value[i]) {
+			return "", "", false
+		}
+	}
+
+	decoded, err := url.PathUnescape(value)
+	if err != nil {
+		return "", "", false
+	}
+
+	return key, decoded, true
+}
+
+// encodeValue percent-encodes all characters that are not allowed in a baggage value, as well as
+// the percent sign itself
+func encodeValue(s string) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isBaggageOctet(c) && c != '%' {
+			buf.WriteByte(c)
+			continue
+		}
+
+		buf.WriteByte('%')
+		buf.WriteByte(hexDigits[c>>4])
+		buf.WriteByte(hexDigits[c&0x0f])
+	}
+
+	return buf.String()
+}
+
+// isBaggageOctet reports whether c is allowed in a baggage value, i.e. is a US-ASCII character
+// excluding CTLs, whitespace, DQUOTE, comma, semicolon and backslash
+func isBaggageOctet(c byte) bool {
+	return c == 0x21 ||
+		(c >= 0x23 && c <= 0x2b) ||
+		(c >= 0x2d && c <= 0x3a) ||
+		(c >= 0x3c && c <= 0x5b) ||
+		(c >= 0x5d && c <= 0x7e)
+}
+
+// isToken reports whether s is a valid RFC 7230 token
+func isToken(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,266 @@
+// (c) Copyright IBM Corp. 2026
+
+package baggage_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/instana/go-sensor/baggage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	examples := map[string]struct {
+		Header   string
+		Expected []baggage.Member
+	}{
+		"empty": {},
+		"single member": {
+			Header:   "userId=alice",
+			Expected: []baggage.Member{{Key: "userId", Value: "alice"}},
+		},
+		"multiple members": {
+			Header: "userId=alice,serverNode=DF%2028,isProduction=false",
+			Expected: []baggage.Member{
+				{Key: "userId", Value: "alice"},
+				{Key: "serverNode", Value: "DF 28"},
+				{Key: "isProduction", Value: "false"},
+			},
+		},
+		"with whitespaces": {
+			Header: " userId = alice ,\tserverNode=DF28 ",
+			Expected: []baggage.Member{
+				{Key: "userId", Value: "alice"},
+				{Key: "serverNode", Value: "DF28"},
+			},
+		},
+		"with properties": {
+			Header: "userId=alice;ttl=60;sensitive, serverNode=DF28",
+			Expected: []baggage.Member{
+				{
+					Key:   "userId",
+					Value: "alice",
+					Properties: []baggage.Property{
+						{Key: "ttl", Value: "60", HasValue: true},
+						{Key: "sensitive"},
+					},
+				},
+				{Key: "serverNode", Value: "DF28"},
+			},
+		},
+		"percent-encoded value": {
+			Header:   "key1=value1%2Cvalue2%3B%25",
+			Expected: []baggage.Member{{Key: "key1", Value: "value1,value2;%"}},
+		},
+		"duplicate keys": {
+			Header:   "key1=value1,key1=value2",
+			Expected: []baggage.Member{{Key: "key1", Value: "value2"}},
+		},
+		"malformed members": {
+			Header: "key1,=value2,key 3=value3,key4=val\"ue4,key5=value5,key6=%zz,key7=value7;prop 1",
+			Expected: []baggage.Member{
+				{Key: "key5", Value: "value5"},
+			},
+		},
+		"empty list items": {
+			Header:   ",,key1=value1,  ,",
+			Expected: []baggage.Member{{Key: "key1", Value: "value1"}},
+		},
+		"empty value": {
+			Header:   "key1=",
+			Expected: []baggage.Member{{Key: "key1"}},
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			b := baggage.Parse(example.Header)
+			assert.Equal(t, len(example.Expected), b.Len())
+			assert.Equal(t, example.Expected, b.Members())
+		})
+	}
+}
+
+func TestParse_Limits(t *testing.T) {
+	t.Run("max members", func(t *testing.T) {
+		var items []string
+		for i := 0; i < baggage.MaxMembers+10; i++ {
+			items = append(items, fmt.Sprintf("k%d=v", i))
+		}
+
+		b := baggage.Parse(strings.Join(items, ","))
+		assert.Equal(t, baggage.MaxMembers, b.Len())
+
+		_, ok := b.Member(fmt.Sprintf("k%d", baggage.MaxMembers-1))
+		assert.True(t, ok)
+
+		_, ok = b.Member(fmt.Sprintf("k%d", baggage.MaxMembers))
+		assert.False(t, ok)
+	})
+
+	t.Run("max member length", func(t *testing.T) {
+		b := baggage.Parse("key1=" + strings.Repeat("x", baggage.MaxMemberLength) + ",key2=value2")
+		assert.Equal(t, []baggage.Member{{Key: "key2", Value: "value2"}}, b.Members())
+	})
+
+	t.Run("max length", func(t *testing.T) {
+		value := strings.Repeat("x", 3000)
+		b := baggage.Parse("key1=" + value + ",key2=" + value + ",key3=" + value + ",key4=value4")
+
+		assert.Equal(t, []baggage.Member{
+			{Key: "key1", Value: value},
+			{Key: "key2", Value: value},
+			{Key: "key4", Value: "value4"},
+		}, b.Members())
+	})
+}
+
+func TestBaggage_String(t *testing.T) {
+	b := baggage.New(
+		baggage.Member{Key: "userId", Value: "alice"},
+		baggage.Member{
+			Key:   "serverNode",
+			Value: "DF 28,\"main\";100%",
+			Properties: []baggage.Property{
+				{Key: "ttl", Value: "60 s", HasValue: true},
+				{Key: "sensitive"},
+			},
+		},
+		baggage.Member{Key: "invalid key", Value: "value"},
+	)
+
+	assert.Equal(t, "userId=alice,serverNode=DF%2028%2C%22main%22%3B100%25;ttl=60%20s;sensitive", b.String())
+}
+
+func TestBaggage_String_Roundtrip(t *testing.T) {
+	b := baggage.FromMap(map[string]string{
+		"key1": "value with spaces",
+		"key2": "ünïcödé",
+		"key3": "a=b;c,d\\e",
+	})
+
+	assert.Equal(t, b, baggage.Parse(b.String()))
+}
+
+func TestBaggage_String_Limits(t *testing.T) {
+	t.Run("max members", func(t *testing.T) {
+		m := make(map[string]string)
+		for i := 0; i < baggage.MaxMembers+10; i++ {
+			m[fmt.Sprintf("k%03d", i)] = "v"
+		}
+
+		assert.Equal(t, baggage.MaxMembers, baggage.Parse(baggage.FromMap(m).String()).Len())
+	})
+
+	t.Run("max member length", func(t *testing.T) {
+		b := baggage.New(
+			baggage.Member{Key: "key1", Value: strings.Repeat(" ", baggage.MaxMemberLength/3)},
+			baggage.Member{Key: "key2", Value: "value2"},
+		)
+
+		assert.Equal(t, "key2=value2", b.String())
+	})
+
+	t.Run("max length", func(t *testing.T) {
+		value := strings.Repeat("x", 3000)
+		b := baggage.New(
+			baggage.Member{Key: "key1", Value: value},
+			baggage.Member{Key: "key2", Value: value},
+			baggage.Member{Key: "key3", Value: value},
+			baggage.Member{Key: "key4", Value: "value4"},
+		)
+
+		s := b.String()
+		assert.LessOrEqual(t, len(s), baggage.MaxLength)
+		assert.Equal(t, "key1="+value+",key2="+value+",key4=value4", s)
+	})
+}
+
+func TestBaggage_Set(t *testing.T) {
+	b := baggage.New(
+		baggage.Member{Key: "key1", Value: "value1"},
+		baggage.Member{Key: "key2", Value: "value2"},
+	)
+
+	updated := b.Set(baggage.Member{Key: "key1", Value: "value3"}).Set(baggage.Member{Key: "key4", Value: "value4"})
+
+	assert.Equal(t, "key1=value3,key2=value2,key4=value4", updated.String())
+	assert.Equal(t, "key1=value1,key2=value2", b.String(), "the original baggage should not be modified")
+}
+
+func TestBaggage_SetValue(t *testing.T) {
+	b := baggage.Parse("key1=value1;ttl=60,key2=value2")
+
+	assert.Equal(t, "key1=value3;ttl=60,key2=value2,key4=value4", b.SetValue("key1", "value3").SetValue("key4", "value4").String())
+}
+
+func TestBaggage_Delete(t *testing.T) {
+	b := baggage.New(
+		baggage.Member{Key: "key1", Value: "value1"},
+		baggage.Member{Key: "key2", Value: "value2"},
+	)
+
+	assert.Equal(t, "key2=value2", b.Delete("key1").String())
+	assert.Equal(t, "key1=value1,key2=value2", b.Delete("key3").String())
+	assert.Equal(t, "key1=value1,key2=value2", b.String())
+}
+
+func TestBaggage_Map(t *testing.T) {
+	b := baggage.Parse("key1=value1;ttl=60,key2=value%202")
+	assert.Equal(t, map[string]string{
+		"key1": "value1",
+		"key2": "value 2",
+	}, b.Map())
+}
+
+func TestExtract(t *testing.T) {
+	headers := http.Header{
+		"Baggage":      {"key1=value1,key2=value2", "key3=value3,key1=value4"},
+		"Content-Type": {"text/plain"},
+	}
+
+	b, err := baggage.Extract(headers)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"key1": "value4",
+		"key2": "value2",
+		"key3": "value3",
+	}, b.Map())
+}
+
+func TestExtract_NotFound(t *testing.T) {
+	_, err := baggage.Extract(http.Header{"Content-Type": {"text/plain"}})
+	assert.Equal(t, baggage.ErrBaggageNotFound, err)
+}
+
+func TestInject(t *testing.T) {
+	headers := http.Header{
+		"baggage":      {"key1=value1"},
+		"Content-Type": {"text/plain"},
+	}
+
+	baggage.Inject(baggage.New(baggage.Member{Key: "key2", Value: "value 2"}), headers)
+
+	assert.Equal(t, http.Header{
+		"Baggage":      {"key2=value%202"},
+		"Content-Type": {"text/plain"},
+	}, headers)
+}
+
+func TestInject_Empty(t *testing.T) {
+	headers := http.Header{
+		"Baggage":      {"key1=value1"},
+		"Content-Type": {"text/plain"},
+	}
+
+	baggage.Inject(baggage.Baggage{}, headers)
+
+	assert.Equal(t, http.Header{
+		"Content-Type": {"text/plain"},
+	}, headers)
+}
@@ -0,0 +1,229 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/instana/go-sensor/w3ctrace"
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// The binary trace context encoding has the following layout:
+//
+//	version (1 byte) | flags (1 byte) | trace ID high (8 bytes) | trace ID (8 bytes) | span ID (8 bytes)
+//	[ correlation type (string) | correlation ID (string) ]   if binaryFlagCorrelation is set
+//	[ traceparent (string) | tracestate (string) ]             if binaryFlagW3C is set
+//	baggage items count (uvarint) | [ key (string) | value (string) ]...
+//
+// Strings are encoded as a uvarint length followed by the bytes. IDs are big-endian. Newer versions of
+// the format may only append data to this layout, so that older tracers can still read the trace context.
+const binaryFormatVersion byte = 1
+
+// Binary trace context flags
+const (
+	binaryFlagSuppressed byte = 1 << iota
+	binaryFlagCorrelation
+	binaryFlagW3C
+)
+
+// maxBinaryContextSize limits the amount of data read from the binary carrier
+const maxBinaryContextSize = 64 * 1024
+
+var errBinaryContextTooLarge = errors.New("binary trace context is too large")
+
+func injectBinaryTraceContext(sc SpanContext, opaqueCarrier interface{}) error {
+	w, ok := opaqueCarrier.(io.Writer)
+	if !ok {
+		return ot.ErrInvalidCarrier
+	}
+
+	_, err := w.Write(encodeBinaryTraceContext(sc))
+
+	return err
+}
+
+func extractBinaryTraceContext(opaqueCarrier interface{}) (SpanContext, error) {
+	r, ok := opaqueCarrier.(io.Reader)
+	if !ok {
+		return SpanContext{}, ot.ErrInvalidCarrier
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxBinaryContextSize+1))
+	if err != nil {
+		return SpanContext{}, err
+	}
+
+	if len(data) > maxBinaryContextSize {
+		return SpanContext{}, errBinaryContextTooLarge
+	}
+
+	if len(data) == 0 {
+		return SpanContext{}, ot.ErrSpanContextNotFound
+	}
+
+	sc, err := decodeBinaryTraceContext(data)
+	if err != nil {
+		return SpanContext{}, err
+	}
+
+	// reset the trace IDs if a correlation ID has been provided
+	if sc.Correlation.ID != "" {
+		sc.TraceIDHi, sc.TraceID, sc.SpanID = 0, 0, 0
+
+		return sc, nil
+	}
+
+	if sc.IsZero() {
+		return sc, ot.ErrSpanContextNotFound
+	}
+
+	return sc, nil
+}
+
+func encodeBinaryTraceContext(sc SpanContext) []byte {
+	var flags byte
+	if sc.Suppressed {
+		flags |= binaryFlagSuppressed
+	}
+
+	if sc.Correlation.ID != "" {
+		flags |= binaryFlagCorrelation
+	}
+
+	if !sc.W3CContext.IsZero() {
+		flags |= binaryFlagW3C
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 64))
+	buf.WriteByte(binaryFormatVersion)
+	buf.WriteByte(flags)
+
+	var id [8]byte
+	for _, v := range []int64{sc.TraceIDHi, sc.TraceID, sc.SpanID} {
+		binary.BigEndian.PutUint64(id[:], uint64(v))
+		buf.Write(id[:])
+	}
+
+	if flags&binaryFlagCorrelation != 0 {
+		writeBinaryString(buf, sc.Correlation.Type)
+		writeBinaryString(buf, sc.Correlation.ID)
+	}
+
+	if flags&binaryFlagW3C != 0 {
+		writeBinaryString(buf, sc.W3CContext.RawParent)
+		writeBinaryString(buf, sc.W3CContext.RawState)
+	}
+
+	// sort baggage keys to make the encoding deterministic
+	keys := make([]string, 0, len(sc.Baggage))
+	for k := range sc.Baggage {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var n [binary.MaxVarintLen64]byte
+	buf.Write(n[:binary.PutUvarint(n[:], uint64(len(keys)))])
+
+	for _, k := range keys {
+		writeBinaryString(buf, k)
+		writeBinaryString(buf, sc.Baggage[k])
+	}
+
+	return buf.Bytes()
+}
+
+func decodeBinaryTraceContext(data []byte) (SpanContext, error) {
+	const headerLen = 2 + 3*8
+
+	if len(data) < headerLen || data[0] == 0 {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	// versions newer than binaryFormatVersion extend the layout, so the data is read as the latest
+	// supported version
+	flags := data[1]
+
+	sc := SpanContext{
+		TraceIDHi:  int64(binary.BigEndian.Uint64(data[2:10])),
+		TraceID:    int64(binary.BigEndian.Uint64(data[10:18])),
+		SpanID:     int64(binary.BigEndian.Uint64(data[18:26])),
+		Suppressed: flags&binaryFlagSuppressed != 0,
+		Baggage:    make(map[string]string),
+	}
+
+	r := bytes.NewReader(data[headerLen:])
+
+	var err error
+	if flags&binaryFlagCorrelation != 0 {
+		if sc.Correlation.Type, err = readBinaryString(r); err != nil {
+			return SpanContext{}, ot.ErrSpanContextCorrupted
+		}
+
+		if sc.Correlation.ID, err = readBinaryString(r); err != nil {
+			return SpanContext{}, ot.ErrSpanContextCorrupted
+		}
+	}
+
+	if flags&binaryFlagW3C != 0 {
+		var trCtx w3ctrace.Context
+		if trCtx.RawParent, err = readBinaryString(r); err != nil {
+			return SpanContext{}, ot.ErrSpanContextCorrupted
+		}
+
+		if trCtx.RawState, err = readBinaryString(r); err != nil {
+			return SpanContext{}, ot.ErrSpanContextCorrupted
+		}
+
+		sc.W3CContext = trCtx
+	}
+
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > uint64(r.Len()) {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	for i := uint64(0); i < n; i++ {
+		k, err := readBinaryString(r)
+		if err != nil {
+			return SpanContext{}, ot.ErrSpanContextCorrupted
+		}
+
+		v, err := readBinaryString(r)
+		if err != nil {
+			return SpanContext{}, ot.ErrSpanContextCorrupted
+		}
+
+		sc.Baggage[k] = v
+	}
+
+	return sc, nil
+}
+
+func writeBinaryString(buf *bytes.Buffer, s string) {
+	var n [binary.MaxVarintLen64]byte
+	buf.Write(n[:binary.PutUvarint(n[:], uint64(len(s)))])
+	buf.WriteString(s)
+}
+
+func readBinaryString(r *bytes.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+
+	if n > uint64(r.Len()) {
+		return "", io.ErrUnexpectedEOF
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,185 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/w3ctrace"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracer_Binary_InjectExtract(t *testing.T) {
+	examples := map[string]instana.SpanContext{
+		"instana trace": {
+			TraceIDHi: 0x1,
+			TraceID:   0x2435,
+			SpanID:    0x3546,
+			Baggage: map[string]string{
+				"foo":    "bar",
+				"tenant": "acme",
+			},
+		},
+		"negative ids": {
+			TraceIDHi: -0x7f0e6711a9cbc458,
+			TraceID:   -0x1ba84a5d1b27942f,
+			SpanID:    -0x1,
+			Baggage:   map[string]string{},
+		},
+		"suppressed": {
+			Suppressed: true,
+			Baggage:    map[string]string{},
+		},
+		"with w3c context": {
+			TraceID: 0x2435,
+			SpanID:  0x3546,
+			W3CContext: w3ctrace.Context{
+				RawParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				RawState:  "in=0000000000002435;0000000000003546,rojo=00f067aa0ba902b7",
+			},
+			Baggage: map[string]string{},
+		},
+		"with correlation data": {
+			Correlation: instana.EUMCorrelationData{
+				Type: "web",
+				ID:   "1234",
+			},
+			Baggage: map[string]string{},
+		},
+	}
+
+	for name, sc := range examples {
+		t.Run(name, func(t *testing.T) {
+			c := instana.InitCollector(&instana.Options{
+				AgentClient: alwaysReadyClient{},
+				Recorder:    instana.NewTestRecorder(),
+			})
+			defer instana.ShutdownCollector()
+
+			var buf bytes.Buffer
+			require.NoError(t, c.Tracer().Inject(sc, ot.Binary, &buf))
+
+			extracted, err := c.Tracer().Extract(ot.Binary, &buf)
+			require.NoError(t, err)
+
+			assert.Equal(t, sc, extracted)
+		})
+	}
+}
+
+func TestTracer_Binary_Encoding(t *testing.T) {
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    instana.NewTestRecorder(),
+	})
+	defer instana.ShutdownCollector()
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Tracer().Inject(instana.SpanContext{
+		TraceIDHi:  0x1,
+		TraceID:    0x2435,
+		SpanID:     0x3546,
+		Suppressed: true,
+		Baggage:    map[string]string{"k": "v"},
+	}, ot.Binary, &buf))
+
+	assert.Equal(t, []byte{
+		0x01,                                           // version
+		0x01,                                           // flags
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, // trace ID high
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x35, // trace ID
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x35, 0x46, // span ID
+		0x01,                 // baggage items count
+		0x01, 'k', 0x01, 'v', // baggage item
+	}, buf.Bytes())
+}
+
+func TestTracer_Binary_Extract_NewerVersion(t *testing.T) {
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    instana.NewTestRecorder(),
+	})
+	defer instana.ShutdownCollector()
+
+	data := []byte{
+		0x02, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x35,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x35, 0x46,
+		0x00,
+		0xde, 0xad, 0xbe, 0xef, // data added by a newer version
+	}
+
+	sc, err := c.Tracer().Extract(ot.Binary, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, instana.SpanContext{
+		TraceID: 0x2435,
+		SpanID:  0x3546,
+		Baggage: map[string]string{},
+	}, sc)
+}
+
+func TestTracer_Binary_Extract_Errors(t *testing.T) {
+	examples := map[string]struct {
+		Data     []byte
+		Expected error
+	}{
+		"empty": {
+			Expected: ot.ErrSpanContextNotFound,
+		},
+		"no trace context": {
+			Data:     append([]byte{0x01}, make([]byte, 26)...),
+			Expected: ot.ErrSpanContextNotFound,
+		},
+		"truncated": {
+			Data:     []byte{0x01, 0x00, 0x00, 0x00},
+			Expected: ot.ErrSpanContextCorrupted,
+		},
+		"invalid version": {
+			Data:     append([]byte{0x00}, make([]byte, 26)...),
+			Expected: ot.ErrSpanContextCorrupted,
+		},
+		"truncated baggage": {
+			Data: []byte{
+				0x01, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x35,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x35, 0x46,
+				0x01, 0x05, 'k',
+			},
+			Expected: ot.ErrSpanContextCorrupted,
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			c := instana.InitCollector(&instana.Options{
+				AgentClient: alwaysReadyClient{},
+				Recorder:    instana.NewTestRecorder(),
+			})
+			defer instana.ShutdownCollector()
+
+			_, err := c.Tracer().Extract(ot.Binary, bytes.NewReader(example.Data))
+			assert.Equal(t, example.Expected, err)
+		})
+	}
+}
+
+func TestTracer_Binary_InvalidCarrier(t *testing.T) {
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    instana.NewTestRecorder(),
+	})
+	defer instana.ShutdownCollector()
+
+	assert.Equal(t, ot.ErrInvalidCarrier, c.Tracer().Inject(instana.SpanContext{TraceID: 1, SpanID: 1}, ot.Binary, "carrier"))
+
+	_, err := c.Tracer().Extract(ot.Binary, strings.Builder{})
+	assert.Equal(t, ot.ErrInvalidCarrier, err)
+}
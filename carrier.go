@@ -0,0 +1,140 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"strings"
+
+	"github.com/instana/go-sensor/baggage"
+)
+
+// CarrierHeaders is a storage for message headers, such as message attributes or record headers of
+// a message bus client, that is used by instana.Carrier to propagate the trace context
+type CarrierHeaders interface {
+	Get(key string) (string, bool)
+	Set(key, val string)
+	Del(key string)
+}
+
+// CarrierKeys are the names of message headers used by instana.Carrier to store the trace context. Since some
+// message buses restrict the set of characters allowed in header names, these can be different from the
+// X-INSTANA-* HTTP header names.
+type CarrierKeys struct {
+	// TraceID is the name of the header that holds the trace ID
+	TraceID string
+	// SpanID is the name of the header that holds the span ID
+	SpanID string
+	// Level is the name of the header that holds the trace level
+	Level string
+	// Baggage is the name of the header that holds the baggage items in the W3C Baggage format.
+	// If empty, the baggage is not propagated.
+	Baggage string
+}
+
+// DefaultCarrierKeys returns the message header names that are compatible with the Instana tracers
+// for other languages
+func DefaultCarrierKeys() CarrierKeys {
+	return CarrierKeys{
+		TraceID: "X_INSTANA_T",
+		SpanID:  "X_INSTANA_S",
+		Level:   "X_INSTANA_L",
+		Baggage: baggage.Header,
+	}
+}
+
+// Carrier is a generic trace context carrier for message buses that adapts message headers storage to the
+// opentracing.TextMapReader and opentracing.TextMapWriter interfaces, so it can be used with
+// (opentracing.Tracer).Inject() and (opentracing.Tracer).Extract() and the opentracing.TextMap format:
+//
+//	carrier := instana.NewCarrier(instana.StringMapHeaders(msg.Properties), instana.DefaultCarrierKeys())
+//	sensor.Tracer().Inject(sp.Context(), opentracing.TextMap, carrier)
+type Carrier struct {
+	Headers CarrierHeaders
+	Keys    CarrierKeys
+}
+
+// NewCarrier returns a new trace context carrier that uses provided headers storage and header names
+func NewCarrier(headers CarrierHeaders, keys CarrierKeys) Carrier {
+	return Carrier{
+		Headers: headers,
+		Keys:    keys,
+	}
+}
+
+// Set implements opentracing.TextMapWriter for Carrier
+func (c Carrier) Set(key, val string) {
+	switch strings.ToLower(key) {
+	case FieldT:
+		c.Headers.Set(c.Keys.TraceID, val)
+	case FieldS:
+		c.Headers.Set(c.Keys.SpanID, val)
+	case FieldL:
+		c.Headers.Set(c.Keys.Level, val)
+	case baggage.Header:
+		if c.Keys.Baggage != "" {
+			c.Headers.Set(c.Keys.Baggage, val)
+		}
+	default:
+		if c.Keys.Baggage == "" || len(key) <= len(FieldB) || !strings.EqualFold(key[:len(FieldB)], FieldB) {
+			return
+		}
+
+		// baggage items are propagated within a single header using the W3C Baggage format
+		existing, _ := c.Headers.Get(c.Keys.Baggage)
+		c.Headers.Set(c.Keys.Baggage, baggage.Parse(existing).SetValue(key[len(FieldB):], val).String())
+	}
+}
+
+// ForeachKey implements opentracing.TextMapReader for Carrier
+func (c Carrier) ForeachKey(handler func(key, val string) error) error {
+	keys := []struct{ Header, Key string }{
+		{c.Keys.TraceID, FieldT},
+		{c.Keys.SpanID, FieldS},
+		{c.Keys.Level, FieldL},
+		{c.Keys.Baggage, baggage.Header},
+	}
+
+	for _, k := range keys {
+		if k.Header == "" {
+			continue
+		}
+
+		v, ok := c.Headers.Get(k.Header)
+		if !ok {
+			continue
+		}
+
+		if err := handler(k.Key, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveAll removes the trace context headers previously set by Set(). The baggage header is preserved.
+func (c Carrier) RemoveAll() {
+	c.Headers.Del(c.Keys.TraceID)
+	c.Headers.Del(c.Keys.SpanID)
+	c.Headers.Del(c.Keys.Level)
+}
+
+// StringMapHeaders is a CarrierHeaders implementation for message headers stored as a map of strings,
+// such as Pulsar message properties
+type StringMapHeaders map[string]string
+
+// Get returns the value of a header
+func (h StringMapHeaders) Get(key string) (string, bool) {
+	v, ok := h[key]
+	return v, ok
+}
+
+// Set sets the value of a header
+func (h StringMapHeaders) Set(key, val string) {
+	h[key] = val
+}
+
+// Del removes a header
+func (h StringMapHeaders) Del(key string) {
+	delete(h, key)
+}
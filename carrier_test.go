@@ -0,0 +1,144 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"errors"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCarrier_Set(t *testing.T) {
+	headers := instana.StringMapHeaders{
+		"baggage": "tenant=initech;ttl=60",
+		"other":   "value",
+	}
+
+	c := instana.NewCarrier(headers, instana.DefaultCarrierKeys())
+
+	c.Set(instana.FieldT, "0000000000002435")
+	c.Set("X-Instana-S", "0000000000003546")
+	c.Set(instana.FieldL, "1")
+	c.Set(instana.FieldB+"tenant", "acme")
+	c.Set(instana.FieldB+"user", "jane doe")
+	c.Set("unknown", "value")
+
+	assert.Equal(t, instana.StringMapHeaders{
+		"X_INSTANA_T": "0000000000002435",
+		"X_INSTANA_S": "0000000000003546",
+		"X_INSTANA_L": "1",
+		"baggage":     "tenant=acme;ttl=60,user=jane%20doe",
+		"other":       "value",
+	}, headers)
+}
+
+func TestCarrier_Set_NoBaggageKey(t *testing.T) {
+	headers := instana.StringMapHeaders{}
+
+	c := instana.NewCarrier(headers, instana.CarrierKeys{
+		TraceID: "trace-id",
+		SpanID:  "span-id",
+		Level:   "level",
+	})
+
+	c.Set(instana.FieldT, "0000000000002435")
+	c.Set(instana.FieldS, "0000000000003546")
+	c.Set(instana.FieldL, "1")
+	c.Set(instana.FieldB+"tenant", "acme")
+	c.Set("baggage", "tenant=acme")
+
+	assert.Equal(t, instana.StringMapHeaders{
+		"trace-id": "0000000000002435",
+		"span-id":  "0000000000003546",
+		"level":    "1",
+	}, headers)
+}
+
+func TestCarrier_ForeachKey(t *testing.T) {
+	c := instana.NewCarrier(instana.StringMapHeaders{
+		"X_INSTANA_T": "0000000000002435",
+		"X_INSTANA_S": "0000000000003546",
+		"X_INSTANA_L": "1",
+		"baggage":     "tenant=acme",
+		"other":       "value",
+	}, instana.DefaultCarrierKeys())
+
+	collected := make(map[string]string)
+	require.NoError(t, c.ForeachKey(func(key, val string) error {
+		collected[key] = val
+		return nil
+	}))
+
+	assert.Equal(t, map[string]string{
+		instana.FieldT: "0000000000002435",
+		instana.FieldS: "0000000000003546",
+		instana.FieldL: "1",
+		"baggage":      "tenant=acme",
+	}, collected)
+}
+
+func TestCarrier_ForeachKey_Error(t *testing.T) {
+	c := instana.NewCarrier(instana.StringMapHeaders{
+		"X_INSTANA_T": "0000000000002435",
+		"X_INSTANA_S": "0000000000003546",
+	}, instana.DefaultCarrierKeys())
+
+	var calls int
+	err := c.ForeachKey(func(key, val string) error {
+		calls++
+		return errors.New("something went wrong")
+	})
+
+	assert.EqualError(t, err, "something went wrong")
+	assert.Equal(t, 1, calls)
+}
+
+func TestCarrier_RemoveAll(t *testing.T) {
+	headers := instana.StringMapHeaders{
+		"X_INSTANA_T": "0000000000002435",
+		"X_INSTANA_S": "0000000000003546",
+		"X_INSTANA_L": "1",
+		"baggage":     "tenant=acme",
+	}
+
+	instana.NewCarrier(headers, instana.DefaultCarrierKeys()).RemoveAll()
+
+	assert.Equal(t, instana.StringMapHeaders{
+		"baggage": "tenant=acme",
+	}, headers)
+}
+
+func TestCarrier_InjectExtract(t *testing.T) {
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    instana.NewTestRecorder(),
+	})
+	defer instana.ShutdownCollector()
+
+	sc := instana.SpanContext{
+		TraceID: 0x2435,
+		SpanID:  0x3546,
+		Baggage: map[string]string{
+			"tenant": "acme",
+		},
+	}
+
+	headers := instana.StringMapHeaders{}
+	require.NoError(t, c.Tracer().Inject(sc, ot.TextMap, instana.NewCarrier(headers, instana.DefaultCarrierKeys())))
+
+	assert.Equal(t, instana.StringMapHeaders{
+		"X_INSTANA_T": "0000000000002435",
+		"X_INSTANA_S": "0000000000003546",
+		"X_INSTANA_L": "1",
+		"baggage":     "tenant=acme",
+	}, headers)
+
+	extracted, err := c.Tracer().Extract(ot.TextMap, instana.NewCarrier(headers, instana.DefaultCarrierKeys()))
+	require.NoError(t, err)
+
+	assert.Equal(t, sc, extracted)
+}
@@ -0,0 +1,203 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ConfigSource is the source of a tracer configuration change
+type ConfigSource string
+
+// Valid configuration sources
+const (
+	// AgentConfigSource is the host agent configuration (configuration.yaml)
+	AgentConfigSource ConfigSource = "agent"
+	// FileConfigSource is the configuration file provided via INSTANA_CONFIG_PATH
+	FileConfigSource ConfigSource = "file"
+)
+
+// Names of the settings that can be changed at runtime
+const (
+	SecretsSetting          = "secrets"
+	SecretValuesSetting     = "secret-values"
+	ExtraHTTPHeadersSetting = "extra-http-headers"
+	DisableSpansSetting     = "disable"
+	SamplerSetting          = "sampler"
+	PollRateSetting         = "poll_rate"
+)
+
+// ConfigChange describes a change of the tracer configuration applied while the process is running
+type ConfigChange struct {
+	// Source is the source of the new configuration
+	Source ConfigSource
+	// Settings is the list of changed settings, i.e. instana.SecretsSetting, instana.DisableSpansSetting, etc.
+	Settings []string
+}
+
+// tracerOptions returns a copy of the current tracer options. The options can be changed at runtime,
+// so this method should be used instead of accessing r.options.Tracer directly.
+func (r *sensorS) tracerOptions() TracerOptions {
+	r.optionsMu.RLock()
+	defer r.optionsMu.RUnlock()
+
+	return r.options.Tracer
+}
+
+// applyConfigChange calls apply() to update the sensor options. The update is atomic for readers that use
+// r.tracerOptions(). Once applied, the changes are logged and reported to the (instana.Options).OnConfigChange hook.
+// Since the tracer options are shared by value, apply() must replace maps and slices instead of modifying them.
+func (r *sensorS) applyConfigChange(source ConfigSource, logger LeveledLogger, apply func(opts *Options)) {
+	r.optionsMu.Lock()
+	before, beforeInterval := r.options.Tracer, r.options.Metrics.getTransmissionInterval()
+	apply(r.options)
+	after, afterInterval := r.options.Tracer, r.options.Metrics.getTransmissionInterval()
+	hook := r.options.OnConfigChange
+	r.optionsMu.Unlock()
+
+	settings := changedTracerSettings(before, after)
+
+	// the metrics transmission interval is not set until the initial handshake with the host agent
+	if beforeInterval > 0 && afterInterval != beforeInterval {
+		settings = append(settings, PollRateSetting)
+		r.meter.SetInterval(afterInterval)
+	}
+
+	if len(settings) == 0 {
+		return
+	}
+
+	logger.Info("tracer configuration updated from ", source, ": ", strings.Join(settings, ", "))
+
+	if hook != nil {
+		hook(ConfigChange{
+			Source:   source,
+			Settings: settings,
+		})
+	}
+}
+
+// changedTracerSettings returns the list of runtime-configurable settings that differ between two tracer options
+func changedTracerSettings(before, after TracerOptions) []string {
+	var settings []string
+
+	if !reflect.DeepEqual(before.Secrets, after.Secrets) {
+		settings = append(settings, SecretsSetting)
+	}
+
+	if !reflect.DeepEqual(before.ValueRedactor, after.ValueRedactor) {
+		settings = append(settings, SecretValuesSetting)
+	}
+
+	if !reflect.DeepEqual(before.CollectableHTTPHeaders, after.CollectableHTTPHeaders) {
+		settings = append(settings, ExtraHTTPHeadersSetting)
+	}
+
+	if len(before.DisableSpans) != 0 || len(after.DisableSpans) != 0 {
+		if !reflect.DeepEqual(before.DisableSpans, after.DisableSpans) {
+			settings = append(settings, DisableSpansSetting)
+		}
+	}
+
+	if !reflect.DeepEqual(before.Sampler, after.Sampler) {
+		settings = append(settings, SamplerSetting)
+	}
+
+	return settings
+}
+
+// configFileState is used to detect the changes of the configuration file
+type configFileState struct {
+	modTime time.Time
+	size    int64
+}
+
+func statConfigFile(path string) (configFileState, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return configFileState{}, err
+	}
+
+	return configFileState{
+		modTime: fi.ModTime(),
+		size:    fi.Size(),
+	}, nil
+}
+
+// watchConfigFile periodically checks the configuration file for changes and applies the new configuration
+// until the sensor is shut down
+func (r *sensorS) watchConfigFile(path string, period time.Duration) {
+	state, _ := statConfigFile(path)
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if current, err := getSensor(); err != nil || current != r {
+			return
+		}
+
+		state = r.checkConfigFile(path, state)
+	}
+}
+
+// checkConfigFile reloads the configuration file if it has been changed since the last check and returns its
+// current state
+func (r *sensorS) checkConfigFile(path string, last configFileState) configFileState {
+	state, err := statConfigFile(path)
+	if err != nil {
+		if last != (configFileState{}) {
+			r.logger.Warn("failed to check the configuration file ", path, " for changes: ", err)
+		}
+
+		return configFileState{}
+	}
+
+	if state == last {
+		return state
+	}
+
+	r.logger.Debug("configuration file ", path, " has been changed, reloading")
+	r.reloadConfigFile(path)
+
+	return state
+}
+
+// reloadConfigFile applies the tracing configuration from the INSTANA_CONFIG_PATH file following the same
+// precedence rules that are used during the initialization
+func (r *sensorS) reloadConfigFile(path string) {
+	var fileOpts TracerOptions
+	if err := parseConfigFile(path, &fileOpts); err != nil {
+		r.logger.Warn("failed to reload the configuration file, keeping the current configuration: ", err)
+		return
+	}
+
+	r.applyConfigChange(FileConfigSource, r.logger, func(opts *Options) {
+		disableSpans := make(map[string]bool, len(opts.inCodeDisableSpans)+len(fileOpts.DisableSpans))
+		for k, v := range opts.inCodeDisableSpans {
+			disableSpans[k] = v
+		}
+
+		for k, v := range fileOpts.DisableSpans {
+			disableSpans[k] = v
+		}
+
+		// keep the configuration received from the agent unless it is overridden by the file
+		if len(disableSpans) > 0 || !opts.Tracer.agentDisableSpans {
+			opts.Tracer.DisableSpans = disableSpans
+			opts.Tracer.agentDisableSpans = false
+		}
+
+		// INSTANA_SAMPLER takes precedence over the configuration file. If the sampler has been removed
+		// from the file, the in-code configuration or the default sampling behavior is restored.
+		if _, ok := lookupValidatedEnv("INSTANA_SAMPLER"); !ok {
+			opts.Tracer.Sampler = opts.inCodeSampler
+			if fileOpts.Sampler != nil {
+				opts.Tracer.Sampler = fileOpts.Sampler
+			}
+		}
+	})
+}
@@ -0,0 +1,279 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/instana/go-sensor/secrets"
+	f "github.com/looplab/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensor_applyConfigChange(t *testing.T) {
+	var changes []ConfigChange
+
+	opts := DefaultOptions()
+	opts.OnConfigChange = func(c ConfigChange) {
+		changes = append(changes, c)
+	}
+	opts.Metrics.setTransmissionInterval(1)
+
+	s := &sensorS{
+		options: opts,
+		meter:   newMeter(defaultLogger),
+	}
+
+	tLogger := &testLogger{}
+
+	s.applyConfigChange(AgentConfigSource, tLogger, func(opts *Options) {
+		opts.Tracer.DisableSpans = map[string]bool{"logging": true}
+		opts.Tracer.CollectableHTTPHeaders = []string{"x-custom"}
+		opts.Metrics.setTransmissionInterval(5)
+	})
+
+	assert.Equal(t, []ConfigChange{
+		{
+			Source:   AgentConfigSource,
+			Settings: []string{ExtraHTTPHeadersSetting, DisableSpansSetting, PollRateSetting},
+		},
+	}, changes)
+	assert.Equal(t, "tracer configuration updated from agent: extra-http-headers, disable, poll_rate", tLogger.infoMsg)
+
+	assert.Equal(t, map[string]bool{"logging": true}, s.tracerOptions().DisableSpans)
+	assert.Equal(t, []string{"x-custom"}, s.tracerOptions().CollectableHTTPHeaders)
+
+	require.Len(t, s.meter.interval, 1)
+	assert.Equal(t, 5*time.Second, <-s.meter.interval)
+
+	t.Run("no changes", func(t *testing.T) {
+		changes = nil
+		tLogger.infoMsg = ""
+
+		s.applyConfigChange(AgentConfigSource, tLogger, func(opts *Options) {
+			opts.Tracer.DisableSpans = map[string]bool{"logging": true}
+			opts.Tracer.CollectableHTTPHeaders = []string{"x-custom"}
+		})
+
+		assert.Empty(t, changes)
+		assert.Empty(t, tLogger.infoMsg)
+		assert.Empty(t, s.meter.interval)
+	})
+}
+
+func Test_fsmS_applyHostAgentSettings_Refresh(t *testing.T) {
+	var changes []ConfigChange
+
+	opts := &Options{}
+	opts.applyConfiguration()
+	opts.OnConfigChange = func(c ConfigChange) {
+		changes = append(changes, c)
+	}
+
+	sensor = &sensorS{
+		options: opts,
+		meter:   newMeter(defaultLogger),
+	}
+	defer func() {
+		sensor = nil
+	}()
+
+	r := &fsmS{
+		agentComm: newAgentCommunicator("123", "456", &fromS{}, defaultLogger),
+		fsm:       f.NewFSM("", []f.EventDesc{}, map[string]f.Callback{}),
+		logger:    &testLogger{},
+	}
+
+	resp := agentResponse{
+		Pid:              1234,
+		HostID:           "45664w32",
+		ExtraHTTPHeaders: []string{"x-header-1"},
+	}
+	resp.Secrets.Matcher = "equals"
+	resp.Secrets.List = []string{"password"}
+	resp.Tracing.Disable = []map[string]bool{{"logging": true}}
+	resp.PluginConfig.PollRate = 1
+
+	r.applyHostAgentSettings(resp)
+	changes = nil
+
+	// the agent configuration has been updated
+	resp.Pid = 4321
+	resp.HostID = "other-host"
+	resp.Secrets.List = []string{"password", "token"}
+	resp.ExtraHTTPHeaders = []string{"x-header-2"}
+	resp.Tracing.Disable = []map[string]bool{{"databases": true}}
+	resp.PluginConfig.PollRate = 10
+
+	r.applyHostAgentConfig(resp)
+
+	assert.Equal(t, []ConfigChange{
+		{
+			Source:   AgentConfigSource,
+			Settings: []string{SecretsSetting, ExtraHTTPHeadersSetting, DisableSpansSetting, PollRateSetting},
+		},
+	}, changes)
+
+	tracerOpts := sensor.tracerOptions()
+	assert.Equal(t, secrets.NewEqualsMatcher("password", "token"), tracerOpts.Secrets)
+	assert.Equal(t, []string{"x-header-2"}, tracerOpts.CollectableHTTPHeaders)
+	assert.Equal(t, map[string]bool{"databases": true}, tracerOpts.DisableSpans)
+	assert.Equal(t, 10*time.Second, sensor.options.Metrics.getTransmissionInterval())
+
+	// the process identity established during the announcement is kept
+	assert.Equal(t, newHostAgentFromS(1234, "45664w32"), r.agentComm.from)
+
+	// the tracing disable configuration has been removed from the agent configuration
+	changes = nil
+	resp.Tracing.Disable = nil
+
+	r.applyHostAgentConfig(resp)
+
+	assert.Equal(t, []ConfigChange{
+		{
+			Source:   AgentConfigSource,
+			Settings: []string{DisableSpansSetting},
+		},
+	}, changes)
+	assert.Empty(t, sensor.tracerOptions().DisableSpans)
+}
+
+func Test_fsmS_applyHostAgentSettings_Refresh_InCodeConfigPreserved(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Tracer.CollectableHTTPHeaders = []string{"x-in-code"}
+	opts.Tracer.DisableSpans = map[string]bool{"logging": true}
+	opts.applyConfiguration()
+
+	sensor = &sensorS{
+		options: opts,
+		meter:   newMeter(defaultLogger),
+	}
+	defer func() {
+		sensor = nil
+	}()
+
+	r := &fsmS{
+		agentComm: newAgentCommunicator("123", "456", &fromS{}, defaultLogger),
+		fsm:       f.NewFSM("", []f.EventDesc{}, map[string]f.Callback{}),
+		logger:    &testLogger{},
+	}
+
+	for _, headers := range []string{"x-header-1", "x-header-2"} {
+		resp := agentResponse{ExtraHTTPHeaders: []string{headers}}
+		resp.Tracing.Disable = []map[string]bool{{"databases": true}}
+
+		r.applyHostAgentConfig(resp)
+
+		assert.Equal(t, []string{"x-in-code"}, sensor.tracerOptions().CollectableHTTPHeaders)
+		assert.Equal(t, map[string]bool{"logging": true}, sensor.tracerOptions().DisableSpans)
+	}
+}
+
+func TestSensor_checkConfigFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`tracing:
+  disable:
+    - logging: true
+`), 0644))
+
+	t.Setenv("INSTANA_CONFIG_PATH", configPath)
+
+	var changes []ConfigChange
+
+	opts := &Options{
+		Tracer: TracerOptions{
+			DisableSpans: map[string]bool{"messaging": true},
+		},
+		OnConfigChange: func(c ConfigChange) {
+			changes = append(changes, c)
+		},
+	}
+	opts.applyConfiguration()
+
+	require.Equal(t, map[string]bool{"logging": true, "messaging": true}, opts.Tracer.DisableSpans)
+
+	s := &sensorS{
+		options: opts,
+		meter:   newMeter(defaultLogger),
+		logger:  &testLogger{},
+	}
+
+	state, err := statConfigFile(configPath)
+	require.NoError(t, err)
+
+	// the file has not been changed
+	assert.Equal(t, state, s.checkConfigFile(configPath, state))
+	assert.Empty(t, changes)
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`tracing:
+  disable:
+    - databases: true
+  sampler:
+    type: probabilistic
+    argument: 0.5
+`), 0644))
+
+	newState := s.checkConfigFile(configPath, state)
+	assert.NotEqual(t, state, newState)
+
+	assert.Equal(t, []ConfigChange{
+		{
+			Source:   FileConfigSource,
+			Settings: []string{DisableSpansSetting, SamplerSetting},
+		},
+	}, changes)
+
+	tracerOpts := s.tracerOptions()
+	assert.Equal(t, map[string]bool{"databases": true, "messaging": true}, tracerOpts.DisableSpans)
+	assert.Equal(t, NewParentBasedSampler(NewProbabilisticSampler(0.5)), tracerOpts.Sampler)
+
+	// the sampler has been removed from the file
+	require.NoError(t, os.WriteFile(configPath, []byte(`tracing:
+  disable:
+    - databases: true
+`), 0644))
+
+	s.checkConfigFile(configPath, newState)
+
+	require.Len(t, changes, 2)
+	assert.Equal(t, ConfigChange{
+		Source:   FileConfigSource,
+		Settings: []string{SamplerSetting},
+	}, changes[1])
+	assert.Nil(t, s.tracerOptions().Sampler)
+}
+
+func TestSensor_checkConfigFile_InvalidFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`tracing:
+  disable:
+    - logging: true
+`), 0644))
+
+	opts := &Options{
+		Tracer: TracerOptions{
+			DisableSpans: map[string]bool{"logging": true},
+		},
+	}
+
+	tLogger := &testLogger{}
+	s := &sensorS{
+		options: opts,
+		meter:   newMeter(defaultLogger),
+		logger:  tLogger,
+	}
+
+	state, err := statConfigFile(configPath)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`tracing: [invalid`), 0644))
+
+	s.checkConfigFile(configPath, state)
+
+	assert.Contains(t, tLogger.warnMsg, "failed to reload the configuration file")
+	assert.Equal(t, map[string]bool{"logging": true}, s.tracerOptions().DisableSpans)
+}
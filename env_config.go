@@ -79,6 +79,44 @@ func parseInstanaSecrets(s string) (Matcher, error) {
 	return NamedMatcher(matcher, config)
 }
 
+// parseInstanaSecretValues parses the value redaction configuration passed via INSTANA_SECRET_VALUES.
+// The configuration string is expected to have the following format:
+//
+//	INSTANA_SECRET_VALUES := <type>:<value>[,<value>][;<type>:<value>[,<value>]]
+//
+// Where `type` is one of:
+// * `patterns` - a list of built-in value patterns: `credit-card`, `iban`, `jwt`, `bearer-token` and `email`
+// * `regex` - a list of regular expressions, which matches are redacted
+//
+// This function returns an error if the configuration is malformed or does not contain any patterns.
+func parseInstanaSecretValues(s string) (ValueRedactor, error) {
+	var patterns, exprs []string
+
+	for _, group := range strings.Split(s, ";") {
+		if strings.TrimSpace(group) == "" {
+			continue
+		}
+
+		ind := strings.Index(group, ":")
+		if ind < 0 {
+			return nil, fmt.Errorf("malformed secret values configuration: %q", group)
+		}
+
+		typ, config := strings.ToLower(strings.TrimSpace(group[:ind])), strings.Split(group[ind+1:], ",")
+
+		switch typ {
+		case secretValuePatternsConfig:
+			patterns = append(patterns, config...)
+		case secretValueRegexConfig:
+			exprs = append(exprs, config...)
+		default:
+			return nil, fmt.Errorf("unknown secret values configuration type %q", typ)
+		}
+	}
+
+	return NewValueRedactor(patterns, exprs)
+}
+
 // parseInstanaExtraHTTPHeaders parses the tags string passed via INSTANA_EXTRA_HTTP_HEADERS.
 // The header names are expected to come in a semicolon-separated list:
 //
@@ -115,6 +153,22 @@ func parseInstanaTimeout(s string) (time.Duration, error) {
 	return time.Duration(ms) * time.Millisecond, nil
 }
 
+// parseInstanaConfigRefreshInterval parses the configuration refresh interval passed via INSTANA_CONFIG_REFRESH_INTERVAL.
+// The value is expected to be a non-negative integer number of seconds. A value of 0 disables the runtime reconfiguration,
+// in which case this function returns a negative duration.
+func parseInstanaConfigRefreshInterval(s string) (time.Duration, error) {
+	sec, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid configuration refresh interval: %q", s)
+	}
+
+	if sec == 0 {
+		return -1, nil
+	}
+
+	return time.Duration(sec) * time.Second, nil
+}
+
 // parseInstanaTracingDisable processes the INSTANA_TRACING_DISABLE environment variable value
 // and updates the TracerOptions.Disable map accordingly.
 //
@@ -141,6 +195,61 @@ func parseInstanaTracingDisable(value string, opts *TracerOptions) {
 	}
 }
 
+// parseInstanaSampler parses the sampler configuration passed via INSTANA_SAMPLER.
+// The sampler configuration string is expected to have the following format:
+//
+//	INSTANA_SAMPLER := <sampler>[:<argument>]
+//
+// Where `sampler` is one of:
+// * `always` - samples every trace
+// * `never` - drops every trace
+// * `probabilistic` - samples a fraction of traces, the argument is the sampling rate between 0 and 1
+// * `rate-limiting` - samples up to a number of traces per second, the argument is the rate limit
+//
+// The returned sampler respects the sampling decision made upstream for non-root spans.
+func parseInstanaSampler(s string) (Sampler, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, errors.New("empty value for sampler configuration")
+	}
+
+	name, arg := s, ""
+	if ind := strings.Index(s, ":"); ind >= 0 {
+		name, arg = s[:ind], s[ind+1:]
+	}
+
+	return NamedSampler(name, arg)
+}
+
+// parseInstanaPropagators parses the list of trace context propagators passed via INSTANA_PROPAGATORS.
+// The propagators configuration string is expected to have the following format:
+//
+//	INSTANA_PROPAGATORS := <propagator>[,<propagator>...]
+//
+// See instana.NamedPropagator() for the list of supported propagator names. The `instana` and
+// `tracecontext` names are accepted but ignored, since the Instana and W3C Trace Context headers
+// are always propagated.
+func parseInstanaPropagators(s string) ([]Propagator, error) {
+	var ps []Propagator
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch name {
+		case "", InstanaPropagator, "tracecontext":
+			continue
+		}
+
+		p, err := NamedPropagator(name)
+		if err != nil {
+			return nil, err
+		}
+
+		ps = append(ps, p)
+	}
+
+	return ps, nil
+}
+
 // parseConfigFile reads and parses the YAML configuration file at the given path
 // and updates the TracerOptions accordingly.
 //
@@ -148,6 +257,9 @@ func parseInstanaTracingDisable(value string, opts *TracerOptions) {
 // tracing:
 //   disable:
 //     - logging: true
+//   sampler:
+//     type: probabilistic
+//     argument: 0.25
 
 func parseConfigFile(path string, opts *TracerOptions) error {
 	// Validate the file path and security considerations
@@ -165,6 +277,10 @@ func parseConfigFile(path string, opts *TracerOptions) error {
 	type Config struct {
 		Tracing struct {
 			Disable []map[string]bool `yaml:"disable"`
+			Sampler struct {
+				Type     string `yaml:"type"`
+				Argument string `yaml:"argument"`
+			} `yaml:"sampler"`
 		} `yaml:"tracing"`
 	}
 
@@ -187,6 +303,15 @@ func parseConfigFile(path string, opts *TracerOptions) error {
 
 	}
 
+	if config.Tracing.Sampler.Type != "" {
+		sampler, err := NamedSampler(config.Tracing.Sampler.Type, config.Tracing.Sampler.Argument)
+		if err != nil {
+			return fmt.Errorf("invalid sampler configuration: %w", err)
+		}
+
+		opts.Sampler = sampler
+	}
+
 	return nil
 }
 
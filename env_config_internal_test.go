@@ -100,6 +100,29 @@ func TestParseInstanaSecrets_Error(t *testing.T) {
 	}
 }
 
+func TestParseInstanaSecretValues(t *testing.T) {
+	red, err := parseInstanaSecretValues("patterns:email,jwt; regex:ACC-[0-9]+")
+	require.NoError(t, err)
+
+	assert.Equal(t, "<redacted> paid with <redacted>", red.Redact("jdoe@example.com paid with ACC-123"))
+}
+
+func TestParseInstanaSecretValues_Error(t *testing.T) {
+	examples := map[string]string{
+		"empty":           "",
+		"unknown type":    "magic:pew",
+		"unknown pattern": "patterns:ssn",
+		"malformed":       "patterns;email",
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseInstanaSecretValues(example)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestParseInstanaExtraHTTPHeaders(t *testing.T) {
 	examples := map[string]struct {
 		Value    string
@@ -151,6 +174,41 @@ func TestParseInstanaTimeout_Error(t *testing.T) {
 	}
 }
 
+func TestParseInstanaConfigRefreshInterval(t *testing.T) {
+	examples := map[string]struct {
+		Value    string
+		Expected time.Duration
+	}{
+		"positive integer": {"30", 30 * time.Second},
+		"with spaces":      {" 300 ", 5 * time.Minute},
+		"zero":             {"0", -1},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			d, err := parseInstanaConfigRefreshInterval(example.Value)
+			require.NoError(t, err)
+			assert.Equal(t, example.Expected, d)
+		})
+	}
+}
+
+func TestParseInstanaConfigRefreshInterval_Error(t *testing.T) {
+	examples := map[string]string{
+		"empty":            "",
+		"non-number":       "twenty",
+		"duration":         "1m",
+		"negative integer": "-100",
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseInstanaConfigRefreshInterval(example)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestValidateFile(t *testing.T) {
 	tempDir := t.TempDir()
 
@@ -281,3 +339,72 @@ func TestValidateFile(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInstanaSampler(t *testing.T) {
+	examples := map[string]struct {
+		Value    string
+		TraceID  int64
+		Expected bool
+	}{
+		"always":                     {Value: "always", Expected: true},
+		"never":                      {Value: "never", Expected: false},
+		"probabilistic, sampled":     {Value: "probabilistic:0.5", TraceID: 1, Expected: true},
+		"probabilistic, not sampled": {Value: " probabilistic : 0.5", TraceID: 0x7fffffffffffffff, Expected: false},
+		"rate-limiting":              {Value: "rate-limiting:100", Expected: true},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			s, err := parseInstanaSampler(example.Value)
+			require.NoError(t, err)
+
+			assert.Equal(t, example.Expected, s.ShouldSample(SamplingParameters{TraceID: example.TraceID}))
+		})
+	}
+}
+
+func TestParseInstanaSampler_Error(t *testing.T) {
+	for _, v := range []string{"", "probabilistic", "probabilistic:2", "unknown:1"} {
+		t.Run(v, func(t *testing.T) {
+			_, err := parseInstanaSampler(v)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseInstanaPropagators(t *testing.T) {
+	ps, err := parseInstanaPropagators(" instana, B3 ,tracecontext,jaeger,,b3multi")
+	require.NoError(t, err)
+
+	assert.Equal(t, []Propagator{b3SinglePropagator{}, jaegerPropagator{}, b3MultiPropagator{}}, ps)
+}
+
+func TestParseInstanaPropagators_Error(t *testing.T) {
+	_, err := parseInstanaPropagators("b3,xray")
+	assert.Error(t, err)
+}
+
+func TestParseConfigFile_Sampler(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`tracing:
+  sampler:
+    type: probabilistic
+    argument: 0
+`), 0600))
+
+	opts := &TracerOptions{}
+	require.NoError(t, parseConfigFile(configPath, opts))
+
+	require.NotNil(t, opts.Sampler)
+	assert.False(t, opts.Sampler.ShouldSample(SamplingParameters{TraceID: 1}))
+}
+
+func TestParseConfigFile_InvalidSampler(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`tracing:
+  sampler:
+    type: random
+`), 0600))
+
+	assert.Error(t, parseConfigFile(configPath, &TracerOptions{}))
+}
@@ -0,0 +1,169 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxErrorEventsPerSpan is the maximum number of distinct errors attached to a span
+	maxErrorEventsPerSpan = 10
+	// maxErrorCauses is the maximum number of wrapped errors included into an error event
+	maxErrorCauses = 10
+	// maxErrorMessageLength is the maximum length of an error message in bytes
+	maxErrorMessageLength = 1024
+)
+
+// ErrorEvent is an error recorded to a span. The errors with the same type, message and origin recorded to the same
+// span are reported as a single event.
+type ErrorEvent struct {
+	// Timestamp is the time the error has been recorded for the first time in milliseconds since epoch
+	Timestamp uint64 `json:"ts"`
+	// Type is the type name of the error, i.e. *fs.PathError
+	Type string `json:"type"`
+	// Message is the error message
+	Message string `json:"message"`
+	// Causes contains the errors wrapped by this error in the order they are unwrapped
+	Causes []ErrorCause `json:"causes,omitempty"`
+	// Stack is the call stack at the location where the error has been created or recorded
+	Stack []StackFrame `json:"stack,omitempty"`
+	// Count is the number of times this error has been recorded to the span
+	Count int `json:"count"`
+}
+
+// ErrorCause is an error wrapped by the recorded one
+type ErrorCause struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// callersError is an error that keeps the program counters of the location it has been created at
+type callersError interface {
+	error
+	Callers() []uintptr
+}
+
+// newErrorEvent returns an error event for an error-level log field value. If the value is an error, the wrapped
+// errors are added as causes. The call stack is taken from the first error in chain that provides the location it
+// has been created at via Callers() []uintptr method, falling back to the provided one.
+func newErrorEvent(v interface{}, stack []StackFrame, ts time.Time) ErrorEvent {
+	ev := ErrorEvent{
+		Timestamp: uint64(ts.UnixNano()) / uint64(time.Millisecond),
+		Type:      fmt.Sprintf("%T", v),
+		Message:   truncateErrorMessage(fmt.Sprint(v)),
+		Stack:     stack,
+		Count:     1,
+	}
+
+	err, ok := v.(error)
+	if !ok {
+		return ev
+	}
+
+	ev.Causes = errorCauses(err)
+
+	var ce callersError
+	if errors.As(err, &ce) {
+		if st := stackFromCallers(ce.Callers()); len(st) > 0 {
+			ev.Stack = st
+		}
+	}
+
+	return ev
+}
+
+// sameAs returns true if both events describe the same error recorded at the same location
+func (ev ErrorEvent) sameAs(other ErrorEvent) bool {
+	if ev.Type != other.Type || ev.Message != other.Message {
+		return false
+	}
+
+	if len(ev.Stack) == 0 || len(other.Stack) == 0 {
+		return len(ev.Stack) == len(other.Stack)
+	}
+
+	return ev.Stack[0] == other.Stack[0]
+}
+
+// errorCauses walks the tree of errors wrapped by err depth-first and returns them in the order they are
+// visited, including the errors joined with errors.Join()
+func errorCauses(err error) []ErrorCause {
+	var causes []ErrorCause
+
+	queue := unwrapError(err)
+	for len(queue) > 0 && len(causes) < maxErrorCauses {
+		cause := queue[0]
+		queue = append(unwrapError(cause), queue[1:]...)
+
+		causes = append(causes, ErrorCause{
+			Type:    fmt.Sprintf("%T", cause),
+			Message: truncateErrorMessage(cause.Error()),
+		})
+	}
+
+	return causes
+}
+
+func unwrapError(err error) []error {
+	var errs []error
+
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if cause := e.Unwrap(); cause != nil {
+			errs = append(errs, cause)
+		}
+	case interface{ Unwrap() []error }:
+		for _, cause := range e.Unwrap() {
+			if cause != nil {
+				errs = append(errs, cause)
+			}
+		}
+	}
+
+	return errs
+}
+
+func stackFromCallers(pcs []uintptr) []StackFrame {
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	var stack []StackFrame
+
+	frames := runtime.CallersFrames(pcs)
+	for len(stack) < maxStackFrames {
+		fr, more := frames.Next()
+		if fr.Function != "" {
+			stack = append(stack, StackFrame{
+				File:   fr.File,
+				Line:   fr.Line,
+				Method: fr.Function,
+			})
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return stack
+}
+
+// truncateErrorMessage shortens the error message to maxErrorMessageLength bytes without splitting UTF-8 characters
+func truncateErrorMessage(s string) string {
+	if len(s) <= maxErrorMessageLength {
+		return s
+	}
+
+	n := maxErrorMessageLength
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n] + "…"
+}
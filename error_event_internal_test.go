@@ -0,0 +1,48 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateErrorMessage(t *testing.T) {
+	assert.Equal(t, "short message", truncateErrorMessage("short message"))
+
+	msg := truncateErrorMessage("x" + strings.Repeat("ü", maxErrorMessageLength))
+	assert.True(t, utf8.ValidString(msg))
+	assert.True(t, strings.HasSuffix(msg, "…"))
+	assert.LessOrEqual(t, len(strings.TrimSuffix(msg, "…")), maxErrorMessageLength)
+}
+
+func TestErrorCauses_Limit(t *testing.T) {
+	err := errors.New("root cause")
+	for i := 0; i < 2*maxErrorCauses; i++ {
+		err = fmt.Errorf("wrap #%d: %w", i, err)
+	}
+
+	causes := errorCauses(err)
+	assert.Len(t, causes, maxErrorCauses)
+	assert.Equal(t, "*fmt.wrapError", causes[0].Type)
+}
+
+func TestErrorEvent_sameAs(t *testing.T) {
+	stack := []StackFrame{{File: "main.go", Line: 10, Method: "main.main"}}
+
+	ev := ErrorEvent{Type: "*errors.errorString", Message: "error", Stack: stack}
+
+	assert.True(t, ev.sameAs(ErrorEvent{Type: "*errors.errorString", Message: "error", Stack: stack}))
+	assert.False(t, ev.sameAs(ErrorEvent{Type: "*errors.errorString", Message: "another error", Stack: stack}))
+	assert.False(t, ev.sameAs(ErrorEvent{Type: "*errors.errorString", Message: "error"}))
+	assert.False(t, ev.sameAs(ErrorEvent{
+		Type:    "*errors.errorString",
+		Message: "error",
+		Stack:   []StackFrame{{File: "main.go", Line: 12, Method: "main.main"}},
+	}))
+}
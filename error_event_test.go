@@ -0,0 +1,168 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"runtime"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveSpan_RecordError_ErrorEvents(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	_, sp := instana.Start(context.Background(), "entry", instana.WithSpanKind(instana.EntrySpanKind))
+
+	pathErr := &fs.PathError{Op: "open", Path: "config.yaml", Err: fs.ErrNotExist}
+	for i := 0; i < 3; i++ {
+		sp.RecordError(fmt.Errorf("failed to load config: %w", errors.Join(pathErr, context.Canceled)))
+	}
+	sp.RecordError(errors.New("another error"))
+	sp.Finish()
+
+	entrySpan := findSpanByName(t, recorder.GetQueuedSpans(), string(instana.SDKSpanType))
+	assert.Equal(t, 4, entrySpan.Ec)
+
+	require.Len(t, entrySpan.Errors, 2)
+
+	ev := entrySpan.Errors[0]
+	assert.Equal(t, "*fmt.wrapError", ev.Type)
+	assert.Equal(t, "failed to load config: open config.yaml: file does not exist\ncontext canceled", ev.Message)
+	assert.Equal(t, 3, ev.Count)
+	assert.NotZero(t, ev.Timestamp)
+	assert.Equal(t, []instana.ErrorCause{
+		{Type: "*errors.joinError", Message: "open config.yaml: file does not exist\ncontext canceled"},
+		{Type: "*fs.PathError", Message: "open config.yaml: file does not exist"},
+		{Type: "*errors.errorString", Message: "file does not exist"},
+		{Type: "*errors.errorString", Message: "context canceled"},
+	}, ev.Causes)
+
+	require.NotEmpty(t, ev.Stack)
+	assert.Equal(t, "github.com/instana/go-sensor_test.TestActiveSpan_RecordError_ErrorEvents", ev.Stack[0].Method)
+
+	assert.Equal(t, "another error", entrySpan.Errors[1].Message)
+	assert.Equal(t, 1, entrySpan.Errors[1].Count)
+
+	data, err := json.Marshal(entrySpan)
+	require.NoError(t, err)
+
+	var doc struct {
+		Errors []struct {
+			Type   string `json:"type"`
+			Count  int    `json:"count"`
+			Causes []struct {
+				Type string `json:"type"`
+			} `json:"causes"`
+			Stack []struct {
+				Method string `json:"m"`
+			} `json:"stack"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	require.Len(t, doc.Errors, 2)
+	assert.Equal(t, "*fmt.wrapError", doc.Errors[0].Type)
+	assert.Equal(t, 3, doc.Errors[0].Count)
+	assert.Len(t, doc.Errors[0].Causes, 4)
+	assert.Equal(t, ev.Stack[0].Method, doc.Errors[0].Stack[0].Method)
+}
+
+type callersError struct {
+	pcs []uintptr
+}
+
+func newCallersError() error {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(1, pcs)
+
+	return callersError{pcs: pcs[:n]}
+}
+
+func (callersError) Error() string        { return "something went wrong" }
+func (e callersError) Callers() []uintptr { return e.pcs }
+
+func TestSpan_LogFields_ErrorEvents(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	sp := c.StartSpan("entry", ext.SpanKindRPCServer)
+	sp.LogFields(otlog.Error(fmt.Errorf("request failed: %w", newCallersError())))
+	sp.LogFields(otlog.String("error", "rate limit exceeded"))
+	sp.LogFields(otlog.String("warn", "retrying"))
+	sp.Finish()
+
+	entrySpan := findSpanByName(t, recorder.GetQueuedSpans(), string(instana.SDKSpanType))
+	require.Len(t, entrySpan.Errors, 2)
+
+	// the stack is taken from the error that keeps the location it has been created at
+	ev := entrySpan.Errors[0]
+	assert.Equal(t, "request failed: something went wrong", ev.Message)
+	assert.Equal(t, []instana.ErrorCause{
+		{Type: "instana_test.callersError", Message: "something went wrong"},
+	}, ev.Causes)
+
+	require.NotEmpty(t, ev.Stack)
+	assert.Equal(t, "github.com/instana/go-sensor_test.newCallersError", ev.Stack[0].Method)
+
+	// the call stack is not captured for logged errors
+	ev = entrySpan.Errors[1]
+	assert.Equal(t, "string", ev.Type)
+	assert.Equal(t, "rate limit exceeded", ev.Message)
+	assert.Empty(t, ev.Causes)
+	assert.Empty(t, ev.Stack)
+}
+
+func TestSpan_LogFields_ErrorEventsLimit(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	sp := c.StartSpan("entry", ext.SpanKindRPCServer)
+	for i := 0; i < 20; i++ {
+		sp.LogFields(otlog.Error(fmt.Errorf("error #%d", i)))
+	}
+	sp.Finish()
+
+	entrySpan := findSpanByName(t, recorder.GetQueuedSpans(), string(instana.SDKSpanType))
+	assert.Equal(t, 20, entrySpan.Ec)
+
+	require.Len(t, entrySpan.Errors, 10)
+	assert.Equal(t, "error #0", entrySpan.Errors[0].Message)
+	assert.Equal(t, "error #9", entrySpan.Errors[9].Message)
+}
+
+func findSpanByName(t *testing.T, spans []instana.Span, name string) instana.Span {
+	t.Helper()
+
+	for _, sp := range spans {
+		if sp.Name == name {
+			return sp
+		}
+	}
+
+	require.FailNow(t, "span not found", "no %q span among %d recorded spans", name, len(spans))
+
+	return instana.Span{}
+}
@@ -45,6 +45,7 @@ func isValidPollRate(seconds int) bool {
 }
 
 type fsmS struct {
+	refreshOnce                sync.Once
 	agentComm                  *agentCommunicator
 	fsm                        *f.FSM
 	timer                      *time.Timer
@@ -247,8 +248,10 @@ func (r *fsmS) handleRetries(e *f.Event, cb func(_ context.Context, e *f.Event),
 	r.scheduleRetryWithExponentialDelay(e, cb, retryNumber)
 }
 
-func (r *fsmS) checkAndApplyHostAgentSecrets(resp agentResponse) error {
-	if !isTracerDefaultSecretsSet(sensor.options.Tracer) {
+func (r *fsmS) checkAndApplyHostAgentSecrets(opts *Options, resp agentResponse) error {
+	r.applyHostAgentSecretValues(opts, resp)
+
+	if !isTracerDefaultSecretsSet(opts.Tracer) {
 		r.logger.Info("identified custom defined secrets matcher. Ignoring host agent default secrets configuration.")
 		return nil
 	}
@@ -263,29 +266,88 @@ func (r *fsmS) checkAndApplyHostAgentSecrets(resp agentResponse) error {
 		return fmt.Errorf("failed to apply secrets matcher configuration: %s", err)
 	}
 
-	sensor.options.Tracer.Secrets = m
+	opts.Tracer.Secrets = m
 
 	return nil
 }
 
+// applyHostAgentSecretValues configures the value redactor using the host agent configuration, unless
+// it has been configured via env or in code
+func (r *fsmS) applyHostAgentSecretValues(opts *Options, resp agentResponse) {
+	if len(resp.SecretValues.Patterns) == 0 && len(resp.SecretValues.Regex) == 0 {
+		return
+	}
+
+	if opts.Tracer.ValueRedactor != nil && !opts.Tracer.agentValueRedactor {
+		r.logger.Info("identified custom defined secret values redactor. Ignoring host agent secret values configuration.")
+		return
+	}
+
+	red, err := NewValueRedactor(resp.SecretValues.Patterns, resp.SecretValues.Regex)
+	if err != nil {
+		r.logger.Error("failed to apply host agent secret values configuration: ", err)
+		return
+	}
+
+	opts.Tracer.ValueRedactor = red
+	opts.Tracer.agentValueRedactor = true
+}
+
+// applyHostAgentSettings applies the configuration received from the host agent. The changes are applied
+// atomically, so that the tracer never observes a partially updated configuration.
 func (r *fsmS) applyHostAgentSettings(resp agentResponse) {
 	r.agentComm.mu.Lock()
 	r.agentComm.from = newHostAgentFromS(int(resp.Pid), resp.HostID)
 	r.agentComm.mu.Unlock()
 
-	if err := r.checkAndApplyHostAgentSecrets(resp); err != nil {
-		r.logger.Error(err.Error())
-	}
-	r.logger.Debug("secret Matcher used: ", sensor.options.Tracer.Secrets)
+	r.applyHostAgentConfig(resp)
+}
 
-	if len(sensor.options.Tracer.CollectableHTTPHeaders) == 0 {
-		sensor.options.Tracer.CollectableHTTPHeaders = resp.getExtraHTTPHeaders()
-	}
+// applyHostAgentConfig applies the tracer configuration received from the host agent without updating
+// the process identity used to report the data
+func (r *fsmS) applyHostAgentConfig(resp agentResponse) {
+	sensor.applyConfigChange(AgentConfigSource, r.logger, func(opts *Options) {
+		if err := r.checkAndApplyHostAgentSecrets(opts, resp); err != nil {
+			r.logger.Error(err.Error())
+		}
+		r.logger.Debug("secret Matcher used: ", opts.Tracer.Secrets)
+
+		if len(opts.Tracer.CollectableHTTPHeaders) == 0 || opts.Tracer.agentHTTPHeaders {
+			opts.Tracer.CollectableHTTPHeaders = resp.getExtraHTTPHeaders()
+			opts.Tracer.agentHTTPHeaders = len(opts.Tracer.CollectableHTTPHeaders) > 0
+		}
+
+		r.applyDisableTracingConfig(opts, resp)
+		r.applyMetricsPollRateConfig(resp)
 
-	r.applyDisableTracingConfig(resp)
-	r.applyMetricsPollRateConfig(resp)
+		r.logger.Debug("CollectableHTTPHeaders used: ", opts.Tracer.CollectableHTTPHeaders)
+	})
+}
+
+// refreshHostAgentSettings periodically re-announces the sensor to the host agent to fetch and apply the latest
+// agent configuration until the sensor is shut down. The process identity established during the initial
+// announcement is kept.
+func (r *fsmS) refreshHostAgentSettings(s *sensorS, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if current, err := getSensor(); err != nil || current != s {
+			return
+		}
 
-	r.logger.Debug("CollectableHTTPHeaders used: ", sensor.options.Tracer.CollectableHTTPHeaders)
+		if r.fsm.Current() != "ready" {
+			continue
+		}
+
+		resp := r.agentComm.agentResponse(r.getDiscoveryS())
+		if resp == nil {
+			r.logger.Debug("failed to refresh the host agent configuration, will retry in ", period)
+			continue
+		}
+
+		r.applyHostAgentConfig(*resp)
+	}
 }
 
 // applyMetricsPollRateConfig applies the metrics poll rate configuration from agent response.
@@ -315,16 +377,23 @@ func (r *fsmS) applyMetricsPollRateConfig(resp agentResponse) {
 	s.options.Metrics.setTransmissionInterval(resp.PluginConfig.PollRate)
 }
 
-func (r *fsmS) applyDisableTracingConfig(resp agentResponse) {
+func (r *fsmS) applyDisableTracingConfig(opts *Options, resp agentResponse) {
 	// Do nothing if we have no configuration from the agent
 	if len(resp.Tracing.Disable) == 0 {
 		r.logger.Debug("No tracing disable configuration received from agent")
+
+		// the configuration previously received from the agent has been removed
+		if opts.Tracer.agentDisableSpans {
+			opts.Tracer.DisableSpans = nil
+			opts.Tracer.agentDisableSpans = false
+		}
+
 		return
 	}
 
 	// Check if INSTANA_TRACING_DISABLE environment variable or in-code configuration is set
 	// If it is, it takes precedence over agent configuration
-	isConfigSet := len(sensor.options.Tracer.DisableSpans) != 0
+	isConfigSet := len(opts.Tracer.DisableSpans) != 0 && !opts.Tracer.agentDisableSpans
 	if isConfigSet {
 		r.logger.Info("Disable Tracing configuration is set either through in-code or " +
 			"INSTANA_TRACING_DISABLE environment variable, ignoring agent disable configuration")
@@ -333,16 +402,20 @@ func (r *fsmS) applyDisableTracingConfig(resp agentResponse) {
 
 	r.logger.Debug("Applying tracing disable configuration from agent")
 
-	// Apply the configuration from the agent
-	sensor.options.Tracer.DisableSpans = make(map[string]bool)
+	// Apply the configuration from the agent. A new map is created each time, since the previous one
+	// might still be in use.
+	disableSpans := make(map[string]bool)
 	for _, item := range resp.Tracing.Disable {
 		for k, v := range item {
 			if v {
 				r.logger.Debug("Disabling tracing for: ", k)
-				sensor.options.Tracer.DisableSpans[k] = v
+				disableSpans[k] = v
 			}
 		}
 	}
+
+	opts.Tracer.DisableSpans = disableSpans
+	opts.Tracer.agentDisableSpans = true
 }
 
 func (fsm *fsmS) announceSensor(_ context.Context, event *f.Event) {
@@ -468,12 +541,23 @@ func (r *fsmS) ready(_ context.Context, e *f.Event) {
 		r.logger.Error(err.Error())
 		return
 	}
+
+	if agent, ok := s.Agent().(*agentS); ok {
+		go agent.replaySpool()
+	}
+
 	interval := s.options.Metrics.getTransmissionInterval()
 	if interval <= 0 {
 		s.options.Metrics.setTransmissionInterval(defaultTransmissionInterval)
 		interval = s.options.Metrics.getTransmissionInterval()
 	}
 	s.meter.Run(interval)
+
+	if period := s.options.ConfigRefreshInterval; period > 0 {
+		r.refreshOnce.Do(func() {
+			go r.refreshHostAgentSettings(s, period)
+		})
+	}
 }
 
 func (r *fsmS) cpuSetFileContent(pid int) string {
@@ -17,6 +17,7 @@ import (
 	"github.com/instana/go-sensor/secrets"
 	f "github.com/looplab/fsm"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testLogger struct {
@@ -458,6 +459,91 @@ func Test_fsmS_applyHostAgentSettings_agent_NotOverride(t *testing.T) {
 
 }
 
+func Test_fsmS_applyHostAgentSettings_SecretValues(t *testing.T) {
+	custom, err := NewValueRedactor([]string{"jwt"}, nil)
+	require.NoError(t, err)
+
+	examples := map[string]struct {
+		Redactor         ValueRedactor
+		FromAgent        bool
+		ExpectedRedacted string
+	}{
+		"not configured": {
+			ExpectedRedacted: "<redacted>",
+		},
+		"configured by agent": {
+			Redactor:         custom,
+			FromAgent:        true,
+			ExpectedRedacted: "<redacted>",
+		},
+		"configured in code": {
+			Redactor:         custom,
+			ExpectedRedacted: "jdoe@example.com",
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			opts := DefaultOptions()
+			opts.Tracer.ValueRedactor = example.Redactor
+			opts.Tracer.agentValueRedactor = example.FromAgent
+
+			sensor = &sensorS{
+				options: opts,
+			}
+			defer func() {
+				sensor = nil
+			}()
+
+			r := &fsmS{
+				agentComm: newAgentCommunicator("123", "456", &fromS{}, defaultLogger),
+				logger:    defaultLogger,
+			}
+
+			var resp agentResponse
+			resp.SecretValues.Patterns = []string{"email"}
+
+			r.applyHostAgentSettings(resp)
+
+			require.NotNil(t, sensor.options.Tracer.ValueRedactor)
+			assert.Equal(t, example.ExpectedRedacted, sensor.options.Tracer.ValueRedactor.Redact("jdoe@example.com"))
+		})
+	}
+}
+
+func Test_fsmS_checkAndApplyHostAgentSecrets_OptionsOnly(t *testing.T) {
+	current := DefaultOptions()
+	current.Tracer.tracerDefaultSecrets = true
+
+	sensor = &sensorS{
+		options: current,
+	}
+	defer func() {
+		sensor = nil
+	}()
+
+	opts := DefaultOptions()
+	opts.Tracer.tracerDefaultSecrets = true
+
+	r := &fsmS{
+		logger: defaultLogger,
+	}
+
+	var resp agentResponse
+	resp.Secrets.Matcher = "equals"
+	resp.Secrets.List = []string{"custom"}
+	resp.SecretValues.Patterns = []string{"email"}
+
+	require.NoError(t, r.checkAndApplyHostAgentSecrets(opts, resp))
+
+	assert.True(t, opts.Tracer.Secrets.Match("custom"))
+	assert.NotNil(t, opts.Tracer.ValueRedactor)
+
+	// the options of the running sensor are left intact
+	assert.False(t, sensor.options.Tracer.Secrets.Match("custom"))
+	assert.Nil(t, sensor.options.Tracer.ValueRedactor)
+}
+
 func Test_fsmS_applyHostAgentSettings_agent_secrets_empty_Error(t *testing.T) {
 
 	opts := DefaultOptions()
@@ -617,7 +703,7 @@ func TestApplyDisableTracingConfig(t *testing.T) {
 			fsm := &fsmS{
 				logger: testLogger,
 			}
-			fsm.applyDisableTracingConfig(resp)
+			fsm.applyDisableTracingConfig(sensor.options, resp)
 
 			// Check if the maps have the same size
 			assert.Equal(t, len(tt.expectedDisable), len(sensor.options.Tracer.DisableSpans),
@@ -11,6 +11,9 @@ import (
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// fieldBaggage is the W3C Baggage header that holds the baggage items of the trace context
+const fieldBaggage = "baggage"
+
 // messageCarrier holds the data injected into and extracted from a span's context to assure span correlation
 type messageCarrier struct {
 	headers amqp.Table
@@ -51,7 +54,7 @@ func (c *messageCarrier) Set(key, value string) {
 	}
 
 	switch key {
-	case instana.FieldT, instana.FieldL, instana.FieldS:
+	case instana.FieldT, instana.FieldL, instana.FieldS, fieldBaggage:
 		headers[key] = value
 	}
 }
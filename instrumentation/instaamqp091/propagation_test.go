@@ -0,0 +1,42 @@
+// (c) Copyright IBM Corp. 2026
+
+//go:build go1.16
+// +build go1.16
+
+package instaamqp091
+
+import (
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageCarrier_Set(t *testing.T) {
+	headers := amqp.Table{"custom": "value"}
+	c := &messageCarrier{headers: headers}
+
+	c.Set(instana.FieldT, "0000000000002435")
+	c.Set(instana.FieldS, "0000000000003546")
+	c.Set(instana.FieldL, "1")
+	c.Set(fieldBaggage, "tenant=acme")
+	c.Set(instana.FieldB+"tenant", "acme")
+
+	assert.Equal(t, amqp.Table{
+		"custom":       "value",
+		instana.FieldT: "0000000000002435",
+		instana.FieldS: "0000000000003546",
+		instana.FieldL: "1",
+		"baggage":      "tenant=acme",
+	}, headers)
+
+	collected := make(map[string]string)
+	require.NoError(t, c.ForeachKey(func(key, val string) error {
+		collected[key] = val
+		return nil
+	}))
+
+	assert.Equal(t, "tenant=acme", collected["baggage"])
+}
@@ -22,6 +22,8 @@ const (
 	FieldS = "X_INSTANA_S"
 	// FieldL is the trace level message attribute key
 	FieldL = "X_INSTANA_L"
+	// fieldBaggage is the W3C Baggage message attribute key
+	fieldBaggage = "baggage"
 )
 
 // SpanContextFromSQSMessage returns the trace context from an SQS message
@@ -69,6 +71,8 @@ func (c messageAttributesCarrier) Set(key, val string) {
 		c.Attrs.Set(FieldS, val)
 	case instana.FieldL:
 		c.Attrs.Set(FieldL, val)
+	case fieldBaggage:
+		c.Attrs.Set(fieldBaggage, val)
 	}
 }
 
@@ -85,6 +89,10 @@ func (c messageAttributesCarrier) ForeachKey(handler func(key, val string) error
 		handler(instana.FieldL, v)
 	}
 
+	if v, ok := c.Attrs.Get(fieldBaggage); ok {
+		handler(fieldBaggage, v)
+	}
+
 	return nil
 }
 
@@ -198,6 +198,10 @@ func TestSQSMessageAttributesCarrier_ForeachKey(t *testing.T) {
 				DataType:    aws.String("String"),
 				StringValue: aws.String("1"),
 			},
+			"baggage": {
+				DataType:    aws.String("String"),
+				StringValue: aws.String("tenant=acme"),
+			},
 		},
 	}
 
@@ -220,11 +224,26 @@ func TestSQSMessageAttributesCarrier_ForeachKey(t *testing.T) {
 				instana.FieldT: "0000000000000001deadbeefdeadbeef",
 				instana.FieldS: "abcdef12abcdef12",
 				instana.FieldL: "1",
+				"baggage":      "tenant=acme",
 			}, collected)
 		})
 	}
 }
 
+func TestSQSMessageAttributesCarrier_Set_Baggage(t *testing.T) {
+	attrs := make(map[string]*sqs.MessageAttributeValue)
+	c := instaawssdk.SQSMessageAttributesCarrier(attrs)
+
+	c.Set("baggage", "tenant=acme")
+	c.Set(instana.FieldB+"tenant", "acme")
+	assert.Equal(t, map[string]*sqs.MessageAttributeValue{
+		"baggage": {
+			DataType:    aws.String("String"),
+			StringValue: aws.String("tenant=acme"),
+		},
+	}, attrs)
+}
+
 func TestSNSMessageAttributesCarrier_Set_FieldT(t *testing.T) {
 	attrs := make(map[string]*sns.MessageAttributeValue)
 	c := instaawssdk.SNSMessageAttributesCarrier(attrs)
@@ -27,6 +27,8 @@ const (
 	fieldS = "X_INSTANA_S"
 	// fieldL is the trace level message attribute key
 	fieldL = "X_INSTANA_L"
+	// fieldBaggage is the W3C Baggage message attribute key
+	fieldBaggage = "baggage"
 )
 
 type AWSOperations interface {
@@ -146,6 +148,8 @@ func (c messageAttributesCarrier) Set(key, val string) {
 		c.Attrs.Set(fieldS, val)
 	case instana.FieldL:
 		c.Attrs.Set(fieldL, val)
+	case fieldBaggage:
+		c.Attrs.Set(fieldBaggage, val)
 	}
 }
 
@@ -163,5 +167,9 @@ func (c messageAttributesCarrier) ForeachKey(handler func(key, val string) error
 		err = handler(instana.FieldL, v)
 	}
 
+	if v, ok := c.Attrs.Get(fieldBaggage); ok {
+		err = handler(fieldBaggage, v)
+	}
+
 	return err
 }
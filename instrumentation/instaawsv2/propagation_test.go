@@ -197,6 +197,10 @@ func TestSQSMessageAttributesCarrier_ForeachKey(t *testing.T) {
 				DataType:    stringRef("String"),
 				StringValue: stringRef("1"),
 			},
+			"baggage": {
+				DataType:    stringRef("String"),
+				StringValue: stringRef("tenant=acme"),
+			},
 		},
 	}
 
@@ -219,11 +223,26 @@ func TestSQSMessageAttributesCarrier_ForeachKey(t *testing.T) {
 				instana.FieldT: "0000000000000001deadbeefdeadbeef",
 				instana.FieldS: "abcdef12abcdef12",
 				instana.FieldL: "1",
+				fieldBaggage:   "tenant=acme",
 			}, collected)
 		})
 	}
 }
 
+func TestSQSMessageAttributesCarrier_Set_Baggage(t *testing.T) {
+	attrs := make(map[string]sqstypes.MessageAttributeValue)
+	c := sqsMessageAttributesCarrier(attrs)
+
+	c.Set(fieldBaggage, "tenant=acme")
+	c.Set(instana.FieldB+"tenant", "acme")
+	assert.Equal(t, map[string]sqstypes.MessageAttributeValue{
+		fieldBaggage: {
+			DataType:    stringRef("String"),
+			StringValue: stringRef("tenant=acme"),
+		},
+	}, attrs)
+}
+
 func TestSNSMessageAttributesCarrier_Set_FieldT(t *testing.T) {
 	attrs := make(map[string]snstypes.MessageAttributeValue)
 	c := snsMessageAttributesCarrier(attrs)
@@ -133,6 +133,57 @@ func TestPropagation(t *testing.T) {
 
 }
 
+func TestPanicHandling(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     "beego-test",
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	// panics are only propagated to the middleware when beego does not recover from them
+	cfg := *beego.BConfig
+	cfg.RecoverPanic = false
+
+	app := beego.NewHttpServerWithCfg(&cfg)
+
+	defaultApp := beego.BeeApp
+	beego.BeeApp = app
+	defer func() { beego.BeeApp = defaultApp }()
+
+	instabeego.InstrumentWebServer(c)
+
+	app.Get("/panic", func(ctx *beecontext.Context) {
+		panic("something went wrong")
+	})
+	app.Handlers.Init()
+
+	assert.Panics(t, func() {
+		app.Handlers.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "https://example.com/panic", nil))
+	})
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	entrySpan, logSpan := spans[0], spans[1]
+	if entrySpan.Name != "g.http" {
+		entrySpan, logSpan = logSpan, entrySpan
+	}
+
+	assert.Equal(t, 1, entrySpan.Ec)
+	assert.Equal(t, entrySpan.SpanID, logSpan.ParentID)
+
+	require.IsType(t, instana.HTTPSpanData{}, entrySpan.Data)
+	entrySpanData := entrySpan.Data.(instana.HTTPSpanData)
+
+	assert.Equal(t, http.StatusInternalServerError, entrySpanData.Tags.Status)
+	assert.Equal(t, "something went wrong", entrySpanData.Tags.Error)
+
+	require.IsType(t, instana.LogSpanData{}, logSpan.Data)
+	assert.Equal(t, "ERROR", logSpan.Data.(instana.LogSpanData).Tags.Level)
+}
+
 type alwaysReadyClient struct{}
 
 func (alwaysReadyClient) Ready() bool                                       { return true }
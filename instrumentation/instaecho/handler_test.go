@@ -207,6 +207,44 @@ func TestPropagationWithError(t *testing.T) {
 	}, logData.Tags)
 }
 
+func TestPanicHandling(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	engine := instaecho.New(c)
+	engine.GET("/foo", func(c echo.Context) error {
+		panic("something went wrong")
+	})
+
+	assert.Panics(t, func() {
+		engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "https://example.com/foo", nil))
+	})
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	entrySpan, logSpan := spans[0], spans[1]
+	if entrySpan.Name != "g.http" {
+		entrySpan, logSpan = logSpan, entrySpan
+	}
+
+	assert.Equal(t, 1, entrySpan.Ec)
+	assert.Equal(t, entrySpan.SpanID, logSpan.ParentID)
+
+	require.IsType(t, instana.HTTPSpanData{}, entrySpan.Data)
+	entrySpanData := entrySpan.Data.(instana.HTTPSpanData)
+
+	assert.Equal(t, http.StatusInternalServerError, entrySpanData.Tags.Status)
+	assert.Equal(t, "something went wrong", entrySpanData.Tags.Error)
+
+	require.IsType(t, instana.LogSpanData{}, logSpan.Data)
+	assert.Equal(t, "ERROR", logSpan.Data.(instana.LogSpanData).Tags.Level)
+}
+
 type alwaysReadyClient struct{}
 
 func (alwaysReadyClient) Ready() bool                                       { return true }
@@ -0,0 +1,39 @@
+// (c) Copyright IBM Corp. 2026
+
+package instaotel_test
+
+import (
+	"context"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/instrumentation/instaotel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// This example demonstrates how to register the Instana-backed trace.TracerProvider as the global
+// OpenTelemetry tracer provider, so that the spans created by libraries instrumented with OpenTelemetry
+// are sent to Instana as a part of the same trace.
+func Example() {
+	c := instana.InitCollector(&instana.Options{
+		Service: "my-service",
+	})
+	defer instana.ShutdownCollector()
+
+	otel.SetTracerProvider(instaotel.NewTracerProvider(c))
+
+	// Start and inject a span into context. Normally our instrumentation code does it for you.
+	entry := c.Tracer().StartSpan("entry")
+	defer entry.Finish()
+
+	ctx := instana.ContextWithSpan(context.Background(), entry)
+
+	// The span started by an OpenTelemetry-instrumented library becomes a child of the entry span
+	_, sp := otel.Tracer("my-library").Start(ctx, "process", trace.WithSpanKind(trace.SpanKindInternal))
+	defer sp.End()
+
+	sp.SetAttributes(attribute.String("key", "value"))
+	sp.SetStatus(codes.Error, "something went wrong")
+}
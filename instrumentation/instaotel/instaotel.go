@@ -0,0 +1,353 @@
+// (c) Copyright IBM Corp. 2026
+
+// Package instaotel provides an OpenTelemetry trace API implementation backed by the Instana tracer.
+package instaotel
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/w3ctrace"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+const (
+	// ScopeNameTag is the span tag that holds the name of the OpenTelemetry instrumentation scope
+	ScopeNameTag = "otel.scope.name"
+	// ScopeVersionTag is the span tag that holds the version of the OpenTelemetry instrumentation scope
+	ScopeVersionTag = "otel.scope.version"
+	// StatusDescriptionTag is the span tag that holds the description of an OpenTelemetry error status
+	StatusDescriptionTag = "otel.status_description"
+)
+
+var (
+	_ trace.TracerProvider = (*tracerProvider)(nil)
+	_ trace.Tracer         = (*tracer)(nil)
+	_ trace.Span           = (*span)(nil)
+)
+
+type tracerProvider struct {
+	embedded.TracerProvider
+
+	sensor instana.TracerLogger
+}
+
+// NewTracerProvider returns an OpenTelemetry trace.TracerProvider that uses the Instana tracer to
+// create and send spans. This allows libraries instrumented with the OpenTelemetry trace API to
+// participate in the same traces as the Instana instrumentations:
+//
+//	otel.SetTracerProvider(instaotel.NewTracerProvider(collector))
+//
+// The OpenTelemetry span kind is mapped to the Instana entry, exit and intermediate span kinds,
+// span attributes are added as span tags, and an error status increases the span error count.
+func NewTracerProvider(sensor instana.TracerLogger) trace.TracerProvider {
+	return &tracerProvider{
+		sensor: sensor,
+	}
+}
+
+// Tracer returns an OpenTelemetry trace.Tracer for the instrumentation scope. The scope name and version are
+// added to each span started by this tracer as otel.scope.name and otel.scope.version tags
+func (tp *tracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
+	cfg := trace.NewTracerConfig(opts...)
+
+	return &tracer{
+		provider: tp,
+		scope:    name,
+		version:  cfg.InstrumentationVersion(),
+	}
+}
+
+type tracer struct {
+	embedded.Tracer
+
+	provider *tracerProvider
+	scope    string
+	version  string
+}
+
+// Start starts a new span using the Instana tracer. The span becomes a child of the span found in ctx, which can
+// either be started by an Instana instrumentation, or by an OpenTelemetry tracer, including the remote span contexts
+// extracted by an OpenTelemetry propagator. The returned context holds the new span for both APIs, so that it can
+// be retrieved with trace.SpanFromContext() as well as with instana.SpanFromContext().
+//
+// Links provided with trace.WithLinks() are added to the span only if it has a parent, otherwise they are dropped.
+func (t *tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+
+	tags := ot.Tags{}
+	if t.scope != "" {
+		tags[ScopeNameTag] = t.scope
+	}
+
+	if t.version != "" {
+		tags[ScopeVersionTag] = t.version
+	}
+
+	for _, attr := range cfg.Attributes() {
+		tags[string(attr.Key)] = attr.Value.AsInterface()
+	}
+
+	spanOpts := []ot.StartSpanOption{tags}
+
+	if kind, ok := spanKindOption(cfg.SpanKind()); ok {
+		spanOpts = append(spanOpts, kind)
+	}
+
+	if ts := cfg.Timestamp(); !ts.IsZero() {
+		spanOpts = append(spanOpts, ot.StartTime(ts))
+	}
+
+	if !cfg.NewRoot() {
+		if parent, ok := parentSpanContext(ctx); ok {
+			spanOpts = append(spanOpts, ot.ChildOf(parent))
+
+			// the Instana tracer uses the first reference as the parent and adds all others as span links,
+			// which is why links can only be added to a span that has a parent
+			for _, link := range cfg.Links() {
+				if link.SpanContext.IsValid() {
+					spanOpts = append(spanOpts, ot.FollowsFrom(linkedSpanContext(link.SpanContext)))
+				}
+			}
+		}
+	}
+
+	sp := &span{
+		span:     t.provider.sensor.Tracer().StartSpan(name, spanOpts...),
+		provider: t.provider,
+	}
+
+	return trace.ContextWithSpan(instana.ContextWithSpan(ctx, sp.span), sp), sp
+}
+
+type span struct {
+	embedded.Span
+
+	span     ot.Span
+	provider *tracerProvider
+
+	mu                sync.Mutex
+	ended             bool
+	statusCode        codes.Code
+	statusDescription string
+}
+
+// End finishes the span and sends it to the agent
+func (s *span) End(opts ...trace.SpanEndOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ended {
+		return
+	}
+	s.ended = true
+
+	if s.statusCode == codes.Error {
+		s.span.SetTag(string(ext.Error), true)
+
+		if s.statusDescription != "" {
+			s.span.SetTag(StatusDescriptionTag, s.statusDescription)
+		}
+	}
+
+	cfg := trace.NewSpanEndConfig(opts...)
+	s.span.FinishWithOptions(ot.FinishOptions{
+		FinishTime: cfg.Timestamp(),
+	})
+}
+
+// AddEvent adds an event to the span as a log record
+func (s *span) AddEvent(name string, opts ...trace.EventOption) {
+	if !s.IsRecording() {
+		return
+	}
+
+	cfg := trace.NewEventConfig(opts...)
+	s.span.LogFields(append([]otlog.Field{otlog.String("event", name)}, logFields(cfg.Attributes())...)...)
+}
+
+// AddLink drops the link, since the Instana tracer does not support adding links to an already started span.
+// Use trace.WithLinks() to provide links when starting a span instead.
+func (s *span) AddLink(trace.Link) {}
+
+// IsRecording returns true until the span is ended
+func (s *span) IsRecording() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return !s.ended
+}
+
+// RecordError adds an error log record to the span, which increases its error count. Same as
+// for the OpenTelemetry SDK, the span status remains unchanged.
+func (s *span) RecordError(err error, opts ...trace.EventOption) {
+	if err == nil || !s.IsRecording() {
+		return
+	}
+
+	cfg := trace.NewEventConfig(opts...)
+	s.span.LogFields(append([]otlog.Field{otlog.String("event", "exception"), otlog.Error(err)}, logFields(cfg.Attributes())...)...)
+}
+
+// SpanContext returns the OpenTelemetry representation of the Instana span context
+func (s *span) SpanContext() trace.SpanContext {
+	sc, ok := s.span.Context().(instana.SpanContext)
+	if !ok {
+		return trace.SpanContext{}
+	}
+
+	return otelSpanContext(sc)
+}
+
+// SetStatus sets the status of the span. An error status increases the span error count once the
+// span is ended. Following the OpenTelemetry specification, the Ok status takes precedence over
+// any other one and cannot be changed.
+func (s *span) SetStatus(code codes.Code, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ended || code == codes.Unset || s.statusCode == codes.Ok {
+		return
+	}
+
+	s.statusCode = code
+	s.statusDescription = ""
+
+	if code == codes.Error {
+		s.statusDescription = description
+	}
+}
+
+// SetName changes the span operation name
+func (s *span) SetName(name string) {
+	if !s.IsRecording() {
+		return
+	}
+
+	s.span.SetOperationName(name)
+}
+
+// SetAttributes adds attributes to the span as tags
+func (s *span) SetAttributes(kv ...attribute.KeyValue) {
+	if !s.IsRecording() {
+		return
+	}
+
+	for _, attr := range kv {
+		s.span.SetTag(string(attr.Key), attr.Value.AsInterface())
+	}
+}
+
+// TracerProvider returns the trace.TracerProvider that was used to create the span
+func (s *span) TracerProvider() trace.TracerProvider {
+	return s.provider
+}
+
+// parentSpanContext returns the context of a span stored in ctx. The Instana span is checked
+// first, since the spans started via this package are stored in the context for both APIs,
+// while the spans started by Instana instrumentations are only available via instana.SpanFromContext()
+func parentSpanContext(ctx context.Context) (ot.SpanContext, bool) {
+	if sp, ok := instana.SpanFromContext(ctx); ok {
+		return sp.Context(), true
+	}
+
+	if sp, ok := trace.SpanFromContext(ctx).(*span); ok {
+		return sp.span.Context(), true
+	}
+
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		return instanaSpanContext(sc), true
+	}
+
+	return nil, false
+}
+
+// instanaSpanContext converts a span context created by another OpenTelemetry tracer into an Instana
+// span context. The conversion is done via the W3C trace context, so that the resulting span is handled
+// in the same way as if the context has been received in the traceparent and tracestate headers.
+func instanaSpanContext(sc trace.SpanContext) instana.SpanContext {
+	return instana.SpanContext{
+		Suppressed: !sc.IsSampled(),
+		W3CContext: w3ctrace.Context{
+			RawParent: w3ctrace.Parent{
+				Version:  w3ctrace.Version_Max,
+				TraceID:  sc.TraceID().String(),
+				ParentID: sc.SpanID().String(),
+				Flags:    w3ctrace.Flags{Sampled: sc.IsSampled()},
+			}.String(),
+			RawState: sc.TraceState().String(),
+		},
+	}
+}
+
+// linkedSpanContext converts the context of a linked span into an Instana span context. Unlike instanaSpanContext(),
+// it keeps the trace and span IDs, since the link is not used to continue the trace.
+func linkedSpanContext(sc trace.SpanContext) instana.SpanContext {
+	traceID, spanID := sc.TraceID(), sc.SpanID()
+
+	return instana.SpanContext{
+		TraceIDHi: int64(binary.BigEndian.Uint64(traceID[:8])),
+		TraceID:   int64(binary.BigEndian.Uint64(traceID[8:])),
+		SpanID:    int64(binary.BigEndian.Uint64(spanID[:])),
+	}
+}
+
+func otelSpanContext(sc instana.SpanContext) trace.SpanContext {
+	var (
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	)
+
+	binary.BigEndian.PutUint64(traceID[:8], uint64(sc.TraceIDHi))
+	binary.BigEndian.PutUint64(traceID[8:], uint64(sc.TraceID))
+	binary.BigEndian.PutUint64(spanID[:], uint64(sc.SpanID))
+
+	cfg := trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}
+
+	if !sc.Suppressed {
+		cfg.TraceFlags = trace.FlagsSampled
+	}
+
+	if sc.W3CContext.RawState != "" {
+		if state, err := trace.ParseTraceState(sc.W3CContext.RawState); err == nil {
+			cfg.TraceState = state
+		}
+	}
+
+	return trace.NewSpanContext(cfg)
+}
+
+func spanKindOption(kind trace.SpanKind) (ot.StartSpanOption, bool) {
+	switch kind {
+	case trace.SpanKindServer:
+		return ext.SpanKindRPCServer, true
+	case trace.SpanKindConsumer:
+		return ext.SpanKindConsumer, true
+	case trace.SpanKindClient:
+		return ext.SpanKindRPCClient, true
+	case trace.SpanKindProducer:
+		return ext.SpanKindProducer, true
+	default:
+		return nil, false
+	}
+}
+
+func logFields(attrs []attribute.KeyValue) []otlog.Field {
+	fields := make([]otlog.Field, 0, len(attrs))
+	for _, attr := range attrs {
+		fields = append(fields, otlog.Object(string(attr.Key), attr.Value.AsInterface()))
+	}
+
+	return fields
+}
@@ -0,0 +1,297 @@
+// (c) Copyright IBM Corp. 2026
+
+package instaotel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/acceptor"
+	"github.com/instana/go-sensor/autoprofile"
+	"github.com/instana/go-sensor/instrumentation/instaotel"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestTracerProvider(t *testing.T) (trace.TracerProvider, *instana.Recorder, instana.TracerLogger) {
+	t.Helper()
+
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	t.Cleanup(instana.ShutdownCollector)
+
+	return instaotel.NewTracerProvider(c), recorder, c
+}
+
+func TestTracer_Start(t *testing.T) {
+	tp, recorder, _ := newTestTracerProvider(t)
+	tracer := tp.Tracer("my-library", trace.WithInstrumentationVersion("1.2.3"))
+
+	ctx, sp := tracer.Start(context.Background(), "process",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("key", "value"), attribute.Int("count", 42)),
+	)
+
+	assert.True(t, sp.IsRecording())
+	assert.Equal(t, sp, trace.SpanFromContext(ctx))
+	assert.Same(t, tp, sp.TracerProvider())
+
+	instanaSp, ok := instana.SpanFromContext(ctx)
+	require.True(t, ok)
+
+	sp.SetAttributes(attribute.StringSlice("list", []string{"a", "b"}))
+	sp.SetName("process message")
+	sp.End()
+
+	assert.False(t, sp.IsRecording())
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, int(instana.EntrySpanKind), span.Kind)
+	assert.Equal(t, 0, span.Ec)
+
+	sc := instanaSp.Context().(instana.SpanContext)
+	assert.Equal(t, sc.TraceID, span.TraceID)
+	assert.Equal(t, sc.SpanID, span.SpanID)
+
+	require.IsType(t, instana.SDKSpanData{}, span.Data)
+	data := span.Data.(instana.SDKSpanData)
+
+	assert.Equal(t, "process message", data.Tags.Name)
+	assert.Equal(t, "entry", data.Tags.Type)
+	assert.Equal(t, ot.Tags{
+		"span.kind":          ext.SpanKindRPCServerEnum,
+		"key":                "value",
+		"count":              int64(42),
+		"list":               []string{"a", "b"},
+		"otel.scope.name":    "my-library",
+		"otel.scope.version": "1.2.3",
+	}, data.Tags.Custom["tags"])
+}
+
+func TestTracer_Start_SpanKind(t *testing.T) {
+	examples := map[trace.SpanKind]instana.SpanKind{
+		trace.SpanKindUnspecified: instana.IntermediateSpanKind,
+		trace.SpanKindInternal:    instana.IntermediateSpanKind,
+		trace.SpanKindServer:      instana.EntrySpanKind,
+		trace.SpanKindConsumer:    instana.EntrySpanKind,
+		trace.SpanKindClient:      instana.ExitSpanKind,
+		trace.SpanKindProducer:    instana.ExitSpanKind,
+	}
+
+	for kind, expected := range examples {
+		t.Run(kind.String(), func(t *testing.T) {
+			tp, recorder, c := newTestTracerProvider(t)
+
+			// exit spans without a parent are not sent to the agent
+			entry := c.Tracer().StartSpan("entry")
+			ctx := instana.ContextWithSpan(context.Background(), entry)
+
+			_, sp := tp.Tracer("test").Start(ctx, "test", trace.WithSpanKind(kind))
+			sp.End()
+			entry.Finish()
+
+			spans := recorder.GetQueuedSpans()
+			require.Len(t, spans, 2)
+
+			assert.Equal(t, int(expected), spans[0].Kind)
+		})
+	}
+}
+
+func TestTracer_Start_Parent(t *testing.T) {
+	tp, recorder, c := newTestTracerProvider(t)
+	tracer := tp.Tracer("test")
+
+	// span started by an Instana instrumentation
+	entry := c.Tracer().StartSpan("entry")
+	ctx := instana.ContextWithSpan(context.Background(), entry)
+
+	ctx, parent := tracer.Start(ctx, "parent")
+	_, child := tracer.Start(ctx, "child", trace.WithSpanKind(trace.SpanKindClient))
+	_, root := tracer.Start(ctx, "root", trace.WithNewRoot())
+
+	root.End()
+	child.End()
+	parent.End()
+	entry.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 4)
+
+	rootSp, childSp, parentSp, entrySp := spans[0], spans[1], spans[2], spans[3]
+
+	assert.Equal(t, entrySp.TraceID, parentSp.TraceID)
+	assert.Equal(t, entrySp.SpanID, parentSp.ParentID)
+
+	assert.Equal(t, entrySp.TraceID, childSp.TraceID)
+	assert.Equal(t, parentSp.SpanID, childSp.ParentID)
+
+	assert.NotEqual(t, entrySp.TraceID, rootSp.TraceID)
+	assert.Zero(t, rootSp.ParentID)
+
+	// the OpenTelemetry span context matches the Instana one
+	sc := parent.SpanContext()
+	assert.True(t, sc.IsValid())
+	assert.True(t, sc.IsSampled())
+	assert.Equal(t, instana.FormatLongID(parentSp.TraceIDHi, parentSp.TraceID), sc.TraceID().String())
+	assert.Equal(t, instana.FormatID(parentSp.SpanID), sc.SpanID().String())
+}
+
+func TestTracer_Start_RemoteParent(t *testing.T) {
+	tp, recorder, _ := newTestTracerProvider(t)
+
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+
+	_, sp := tp.Tracer("test").Start(ctx, "test", trace.WithSpanKind(trace.SpanKindServer))
+	sp.End()
+
+	assert.Equal(t, traceID, sp.SpanContext().TraceID())
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", instana.FormatLongID(spans[0].TraceIDHi, spans[0].TraceID))
+	assert.Equal(t, "00f067aa0ba902b7", instana.FormatID(spans[0].ParentID))
+	assert.True(t, spans[0].ForeignTrace)
+}
+
+func TestTracer_Start_Links(t *testing.T) {
+	tp, recorder, c := newTestTracerProvider(t)
+	tracer := tp.Tracer("test")
+
+	entry := c.Tracer().StartSpan("entry")
+	ctx := instana.ContextWithSpan(context.Background(), entry)
+
+	link := trace.Link{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x01, 0x02, 0x03},
+			SpanID:     trace.SpanID{0x04, 0x05, 0x06},
+			TraceFlags: trace.FlagsSampled,
+		}),
+	}
+
+	_, sp := tracer.Start(ctx, "batch", trace.WithLinks(link, trace.Link{}))
+	sp.AddLink(link)
+	sp.End()
+	entry.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	batchSp, entrySp := spans[0], spans[1]
+
+	// links never replace the parent of the span
+	assert.Equal(t, entrySp.TraceID, batchSp.TraceID)
+	assert.Equal(t, entrySp.SpanID, batchSp.ParentID)
+}
+
+func TestSpan_SetStatus(t *testing.T) {
+	examples := map[string]struct {
+		Statuses    []codes.Code
+		ExpectedEc  int
+		Description interface{}
+	}{
+		"unset": {
+			ExpectedEc: 0,
+		},
+		"error": {
+			Statuses:    []codes.Code{codes.Error},
+			ExpectedEc:  1,
+			Description: "something went wrong",
+		},
+		"error then ok": {
+			Statuses:   []codes.Code{codes.Error, codes.Ok},
+			ExpectedEc: 0,
+		},
+		"ok then error": {
+			Statuses:   []codes.Code{codes.Ok, codes.Error},
+			ExpectedEc: 0,
+		},
+		"repeated error": {
+			Statuses:    []codes.Code{codes.Error, codes.Unset, codes.Error},
+			ExpectedEc:  1,
+			Description: "something went wrong",
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			tp, recorder, _ := newTestTracerProvider(t)
+
+			_, sp := tp.Tracer("test").Start(context.Background(), "test")
+			for _, code := range example.Statuses {
+				sp.SetStatus(code, "something went wrong")
+			}
+			sp.End()
+
+			spans := recorder.GetQueuedSpans()
+			require.Len(t, spans, 1)
+
+			assert.Equal(t, example.ExpectedEc, spans[0].Ec)
+
+			tags, _ := spans[0].Data.(instana.SDKSpanData).Tags.Custom["tags"].(ot.Tags)
+			assert.Equal(t, example.Description, tags[instaotel.StatusDescriptionTag])
+		})
+	}
+}
+
+func TestSpan_RecordError(t *testing.T) {
+	tp, recorder, _ := newTestTracerProvider(t)
+
+	_, sp := tp.Tracer("test").Start(context.Background(), "test")
+	sp.AddEvent("message received", trace.WithAttributes(attribute.String("id", "1")))
+	sp.RecordError(errors.New("something went wrong"))
+	sp.RecordError(nil)
+	sp.End()
+
+	// ignored after the span has ended
+	sp.RecordError(errors.New("too late"))
+	sp.End()
+
+	spans := recorder.GetQueuedSpans()
+
+	// the error log record is sent as a separate log.go span
+	require.Len(t, spans, 2)
+
+	var span instana.Span
+	for _, s := range spans {
+		if s.Name == string(instana.SDKSpanType) {
+			span = s
+		}
+	}
+
+	assert.Equal(t, 1, span.Ec)
+}
+
+type alwaysReadyClient struct{}
+
+func (alwaysReadyClient) Ready() bool                                       { return true }
+func (alwaysReadyClient) SendMetrics(data acceptor.Metrics) error           { return nil }
+func (alwaysReadyClient) SendEvent(event *instana.EventData) error          { return nil }
+func (alwaysReadyClient) SendSpans(spans []instana.Span) error              { return nil }
+func (alwaysReadyClient) SendProfiles(profiles []autoprofile.Profile) error { return nil }
+func (alwaysReadyClient) Flush(context.Context) error                       { return nil }
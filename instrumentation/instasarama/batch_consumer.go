@@ -0,0 +1,60 @@
+// (c) Copyright IBM Corp. 2026
+
+//go:build go1.17
+// +build go1.17
+
+package instasarama
+
+import (
+	"strings"
+
+	"github.com/IBM/sarama"
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+// StartConsumerBatchSpan starts an entry span for a batch of Kafka messages that are processed together,
+// i.e. by a sarama.ConsumerGroupHandler that accumulates messages received from (sarama.ConsumerGroupClaim).Messages().
+// The span continues the trace of the first message that carries the trace context and is linked to the traces
+// of all other messages in the batch. The caller is responsible for finishing the returned span once the batch
+// is processed.
+func StartConsumerBatchSpan(msgs []*sarama.ConsumerMessage, sensor instana.TracerLogger) ot.Span {
+	var (
+		topics []string
+		refs   []ot.SpanContext
+	)
+
+	seenTopics := make(map[string]struct{})
+	for _, msg := range msgs {
+		if _, ok := seenTopics[msg.Topic]; !ok {
+			seenTopics[msg.Topic] = struct{}{}
+			topics = append(topics, msg.Topic)
+		}
+
+		if spanContext, ok := SpanContextFromConsumerMessage(msg, sensor); ok {
+			refs = append(refs, spanContext)
+		}
+	}
+
+	opts := []ot.StartSpanOption{
+		ext.SpanKindConsumer,
+		ot.Tags{
+			"kafka.service": strings.Join(topics, ","),
+			"kafka.access":  "consume",
+		},
+		instana.BatchSize(len(msgs)),
+	}
+
+	for i, ref := range refs {
+		if i == 0 {
+			opts = append(opts, ot.ChildOf(ref))
+			continue
+		}
+
+		// the tracer adds any reference other than the parent one as a span link
+		opts = append(opts, ot.FollowsFrom(ref))
+	}
+
+	return sensor.Tracer().StartSpan("kafka", opts...)
+}
@@ -0,0 +1,96 @@
+// (c) Copyright IBM Corp. 2026
+
+//go:build go1.17
+// +build go1.17
+
+package instasarama_test
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/instrumentation/instasarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartConsumerBatchSpan(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	messages := []*sarama.ConsumerMessage{
+		{
+			Topic: "topic-1",
+			Headers: []*sarama.RecordHeader{
+				{Key: []byte("x_instana_t"), Value: []byte("0000000000000000000000000abcde12")},
+				{Key: []byte("x_instana_s"), Value: []byte("00000000deadbeef")},
+				{Key: []byte("x_instana_l_s"), Value: []byte("1")},
+			},
+		},
+		{Topic: "topic-1"},
+		{
+			Topic: "topic-2",
+			Headers: []*sarama.RecordHeader{
+				{Key: []byte("x_instana_t"), Value: []byte("0000000000000000000000000fedcba9")},
+				{Key: []byte("x_instana_s"), Value: []byte("00000000beefdead")},
+				{Key: []byte("x_instana_l_s"), Value: []byte("1")},
+			},
+		},
+		{
+			Topic: "topic-1",
+			Headers: []*sarama.RecordHeader{
+				{Key: []byte("x_instana_t"), Value: []byte("00000000000000000000000001234567")},
+				{Key: []byte("x_instana_s"), Value: []byte("0000000012345678")},
+				{Key: []byte("x_instana_l_s"), Value: []byte("1")},
+			},
+		},
+	}
+
+	sp := instasarama.StartConsumerBatchSpan(messages, c)
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	span, err := extractAgentSpan(spans[0])
+	require.NoError(t, err)
+
+	assert.EqualValues(t, "000000000abcde12", span.TraceID)
+	assert.EqualValues(t, "00000000deadbeef", span.ParentID)
+
+	assert.Equal(t, "kafka", span.Name)
+	assert.EqualValues(t, instana.EntrySpanKind, span.Kind)
+
+	assert.Equal(t, agentKafkaSpanData{
+		Service: "topic-1,topic-2",
+		Access:  "consume",
+	}, span.Data.Kafka)
+
+	require.NotNil(t, spans[0].Batch)
+}
+
+func TestStartConsumerBatchSpan_NoTraceContext(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	sp := instasarama.StartConsumerBatchSpan([]*sarama.ConsumerMessage{
+		{Topic: "topic-1"},
+		{Topic: "topic-1"},
+	}, c)
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Zero(t, spans[0].ParentID)
+	assert.EqualValues(t, instana.EntrySpanKind, spans[0].Kind)
+}
@@ -32,10 +32,14 @@ const (
 	bothFormat   = "both"
 )
 
+// fieldBaggage is the W3C Baggage header that holds the baggage items of the trace context
+const fieldBaggage = "baggage"
+
 var (
-	fieldTKey  = []byte(FieldT)
-	fieldSKey  = []byte(FieldS)
-	fieldLSKey = []byte(FieldLS)
+	fieldTKey       = []byte(FieldT)
+	fieldSKey       = []byte(FieldS)
+	fieldLSKey      = []byte(FieldLS)
+	fieldBaggageKey = []byte(fieldBaggage)
 )
 
 // ProducerMessageWithSpan injects the tracing context into producer message headers to propagate
@@ -86,6 +90,8 @@ func (c ProducerMessageCarrier) Set(key, val string) {
 		c.addOrReplaceHeader(fieldSKey, []byte(val))
 	case instana.FieldL:
 		c.addOrReplaceHeader(fieldLSKey, []byte(val))
+	case fieldBaggage:
+		c.addOrReplaceHeader(fieldBaggageKey, []byte(val))
 	}
 }
 
@@ -123,6 +129,10 @@ func (c ProducerMessageCarrier) ForeachKey(handler func(key, val string) error)
 			if err := handler(instana.FieldL, string(header.Value)); err != nil {
 				return err
 			}
+		case bytes.EqualFold(header.Key, fieldBaggageKey):
+			if err := handler(fieldBaggage, string(header.Value)); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -173,6 +183,8 @@ func (c ConsumerMessageCarrier) Set(key, val string) {
 		c.addOrReplaceHeader(fieldSKey, []byte(val))
 	case instana.FieldL:
 		c.addOrReplaceHeader(fieldLSKey, []byte(val))
+	case fieldBaggage:
+		c.addOrReplaceHeader(fieldBaggageKey, []byte(val))
 	}
 }
 
@@ -213,6 +225,10 @@ func (c ConsumerMessageCarrier) ForeachKey(handler func(key, val string) error)
 			if err := handler(instana.FieldL, string(header.Value)); err != nil {
 				return err
 			}
+		case bytes.EqualFold(header.Key, fieldBaggageKey):
+			if err := handler(fieldBaggage, string(header.Value)); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
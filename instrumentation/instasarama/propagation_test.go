@@ -323,6 +323,7 @@ func TestProducerMessageCarrier_ForeachKey(t *testing.T) {
 		{Key: []byte("x_instana_t"), Value: []byte("000000000000000100000000abcdef12")},
 		{Key: []byte("x_instana_s"), Value: []byte("00000000deadbeef")},
 		{Key: []byte("x_instana_l_s"), Value: []byte("1")},
+		{Key: []byte("baggage"), Value: []byte("tenant=acme")},
 	}...)
 
 	msg := sarama.ProducerMessage{
@@ -340,6 +341,7 @@ func TestProducerMessageCarrier_ForeachKey(t *testing.T) {
 		{Key: instana.FieldT, Value: "000000000000000100000000abcdef12"},
 		{Key: instana.FieldS, Value: "00000000deadbeef"},
 		{Key: instana.FieldL, Value: "1"},
+		{Key: "baggage", Value: "tenant=acme"},
 	}, collected)
 
 }
@@ -730,6 +732,45 @@ func TestConsumerMessageCarrier_Set_FieldL(t *testing.T) {
 	}
 }
 
+func TestProducerMessageCarrier_Set_Baggage(t *testing.T) {
+	msg := sarama.ProducerMessage{
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("X_CUSTOM_1"), Value: []byte("value1")},
+			{Key: []byte("Baggage"), Value: []byte("tenant=initech")},
+		},
+	}
+
+	c := instasarama.ProducerMessageCarrier{&msg}
+	c.Set("baggage", "tenant=acme")
+	c.Set(instana.FieldB+"tenant", "acme")
+
+	assert.Equal(t, []sarama.RecordHeader{
+		{Key: []byte("X_CUSTOM_1"), Value: []byte("value1")},
+		{Key: []byte("Baggage"), Value: []byte("tenant=acme")},
+	}, msg.Headers)
+}
+
+func TestConsumerMessageCarrier_Set_Baggage(t *testing.T) {
+	msg := sarama.ConsumerMessage{}
+
+	c := instasarama.ConsumerMessageCarrier{&msg}
+	c.Set("baggage", "tenant=acme")
+
+	assert.Equal(t, []*sarama.RecordHeader{
+		{Key: []byte("baggage"), Value: []byte("tenant=acme")},
+	}, msg.Headers)
+
+	var collected []struct{ Key, Value string }
+	require.NoError(t, c.ForeachKey(func(k, v string) error {
+		collected = append(collected, struct{ Key, Value string }{k, v})
+		return nil
+	}))
+
+	assert.Equal(t, []struct{ Key, Value string }{
+		{Key: "baggage", Value: "tenant=acme"},
+	}, collected)
+}
+
 func TestConsumerMessageCarrier_RemoveAll(t *testing.T) {
 	msg := sarama.ConsumerMessage{
 		Headers: []*sarama.RecordHeader{
@@ -0,0 +1,35 @@
+// (c) Copyright IBM Corp. 2026
+
+package instaslog_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/instrumentation/instaslog"
+)
+
+// This example demonstrates how to use instaslog.NewHandler() to instrument a slog.Logger with Instana.
+// The instrumented logger adds the trace and span IDs to each record logged with a context, and sends
+// any ERROR and WARN records to Instana, associating them with the current operation span.
+func Example() {
+	c := instana.InitCollector(&instana.Options{
+		Service: "my-service",
+	})
+	defer instana.ShutdownCollector()
+
+	// Wrap the handler of your choice to instrument the logger
+	logger := slog.New(instaslog.NewHandler(slog.NewJSONHandler(os.Stderr, nil), c))
+
+	// Start and inject a span into context. Normally our instrumentation code does it for you.
+	sp := c.Tracer().StartSpan("entry")
+	defer sp.Finish()
+
+	ctx := instana.ContextWithSpan(context.Background(), sp)
+
+	// Make sure to use the context-aware logging methods, so that the handler could correlate
+	// this log record to current operation.
+	logger.ErrorContext(ctx, "something went wrong", "data", "...")
+}
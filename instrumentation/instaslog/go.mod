@@ -0,0 +1,18 @@
+module github.com/instana/go-sensor/instrumentation/instaslog
+
+go 1.25.0
+
+require (
+	github.com/instana/go-sensor v1.74.0
+	github.com/opentracing/opentracing-go v1.2.0
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/pprof v0.0.0-20250630185457-6e76a2b096b5 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/looplab/fsm v1.0.3 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,297 @@
+// (c) Copyright IBM Corp. 2026
+
+// Package instaslog provides Instana instrumentation for the log/slog structured logger.
+package instaslog
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+const (
+	// TraceIDKey is the attribute key used to add the Instana trace ID to a log record
+	TraceIDKey = "trace_id"
+	// SpanIDKey is the attribute key used to add the Instana span ID to a log record
+	SpanIDKey = "span_id"
+
+	// loggingSpanCategory is the instana.TracerOptions.DisableSpans key of log spans
+	loggingSpanCategory = "logging"
+	// maxTrackedSpans is the number of recent parent spans which log span count is tracked
+	maxTrackedSpans = 1024
+)
+
+type handler struct {
+	next   slog.Handler
+	sensor instana.TracerLogger
+
+	// attrs are the attributes added with WithAttrs() with their keys qualified by the open groups
+	attrs []slog.Attr
+	// group is the dot-separated prefix of the groups opened with WithGroup()
+	group string
+
+	counter *logSpanCounter
+}
+
+// NewHandler returns a slog.Handler that adds the IDs of the span found in the context of each log record as
+// the trace_id and span_id attributes and passes the record to the next handler. The warning and error records
+// are also sent to Instana as log spans associated with the current span. Make sure to use the context-aware
+// logging methods, such as slog.Logger.ErrorContext(), for the records to be correlated with the operation:
+//
+//	logger := slog.New(instaslog.NewHandler(slog.NewJSONHandler(os.Stdout, nil), collector))
+//	logger.ErrorContext(ctx, "something went wrong", "user", userID)
+//
+// The log spans are not sent if the "logging" span category is disabled, and no more than
+// instana.TracerOptions.MaxLogsPerSpan log spans are sent per parent span.
+func NewHandler(next slog.Handler, sensor instana.TracerLogger) slog.Handler {
+	return &handler{
+		next:    next,
+		sensor:  sensor,
+		counter: newLogSpanCounter(maxTrackedSpans),
+	}
+}
+
+// Enabled reports whether the record of given level is handled. Warnings and errors are always handled,
+// since they are sent to Instana even if the next handler discards them.
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelWarn || h.next.Enabled(ctx, level)
+}
+
+// Handle adds the trace correlation attributes to the record, sends warnings and errors to Instana and
+// passes the record to the next handler
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	parent, hasParent := instana.SpanFromContext(ctx)
+
+	if r.Level >= slog.LevelWarn {
+		h.sendLogSpan(parent, hasParent, r)
+	}
+
+	if !h.next.Enabled(ctx, r.Level) {
+		return nil
+	}
+
+	if hasParent {
+		if sc, ok := parent.Context().(instana.SpanContext); ok {
+			r = r.Clone()
+			r.AddAttrs(
+				slog.String(TraceIDKey, instana.FormatID(sc.TraceID)),
+				slog.String(SpanIDKey, instana.FormatID(sc.SpanID)),
+			)
+		}
+	}
+
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler that includes given attributes into each record
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	h2 := *h
+	h2.next = h.next.WithAttrs(attrs)
+	h2.attrs = appendAttrs(h.attrs[:len(h.attrs):len(h.attrs)], h.group, attrs...)
+
+	return &h2
+}
+
+// WithGroup returns a handler that qualifies the keys of the following attributes with the group name
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	h2.next = h.next.WithGroup(name)
+	h2.group = qualifiedKey(h.group, name)
+
+	return &h2
+}
+
+func (h *handler) sendLogSpan(parent opentracing.Span, hasParent bool, r slog.Record) {
+	opts := h.sensor.Options()
+	if opts.DisableSpans[loggingSpanCategory] {
+		return
+	}
+
+	spanOpts := []opentracing.StartSpanOption{
+		ext.SpanKindRPCClient,
+	}
+
+	if hasParent {
+		maxLogs := opts.MaxLogsPerSpan
+		if maxLogs <= 0 {
+			maxLogs = instana.MaxLogsPerSpan
+		}
+
+		if sc, ok := parent.Context().(instana.SpanContext); ok && !h.counter.inc(sc.SpanID, maxLogs) {
+			h.sensor.Logger().Debug("dropping log record exceeding the number of logs allowed per span")
+			return
+		}
+
+		spanOpts = append(spanOpts, opentracing.ChildOf(parent.Context()))
+	}
+
+	ts := r.Time
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	tags := opentracing.Tags{
+		"log.level":   convertLevel(r.Level),
+		"log.message": r.Message,
+	}
+
+	attrs := h.attrs[:len(h.attrs):len(h.attrs)]
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = appendAttrs(attrs, h.group, a)
+		return true
+	})
+
+	if len(attrs) > 0 {
+		tags["log.parameters"] = formatAttrs(attrs)
+	}
+
+	spanOpts = append(spanOpts, opentracing.StartTime(ts), tags)
+
+	h.sensor.Tracer().StartSpan(string(instana.LogSpanType), spanOpts...).FinishWithOptions(opentracing.FinishOptions{
+		FinishTime: ts,
+	})
+}
+
+// appendAttrs appends the attributes to dst flattening the groups and qualifying the keys with the group prefix
+func appendAttrs(dst []slog.Attr, group string, attrs ...slog.Attr) []slog.Attr {
+	for _, a := range attrs {
+		a.Value = a.Value.Resolve()
+
+		if a.Equal(slog.Attr{}) {
+			continue
+		}
+
+		if a.Value.Kind() == slog.KindGroup {
+			// attributes of a group with an empty key are inlined
+			dst = appendAttrs(dst, qualifiedKey(group, a.Key), a.Value.Group()...)
+			continue
+		}
+
+		a.Key = qualifiedKey(group, a.Key)
+		dst = append(dst, a)
+	}
+
+	return dst
+}
+
+func qualifiedKey(group, key string) string {
+	switch {
+	case group == "":
+		return key
+	case key == "":
+		return group
+	default:
+		return group + "." + key
+	}
+}
+
+// formatAttrs formats the attributes as space-separated key=value pairs
+func formatAttrs(attrs []slog.Attr) string {
+	var buf strings.Builder
+
+	for i, a := range attrs {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+
+		buf.WriteString(a.Key)
+		buf.WriteByte('=')
+		buf.WriteString(formatValue(a.Value))
+	}
+
+	return buf.String()
+}
+
+func formatValue(v slog.Value) string {
+	var s string
+	switch v.Kind() {
+	case slog.KindTime:
+		return v.Time().Format(time.RFC3339Nano)
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			s = err.Error()
+			break
+		}
+
+		s = v.String()
+	default:
+		s = v.String()
+	}
+
+	if s == "" || strings.ContainsAny(s, " =\"\t\n") {
+		return strconv.Quote(s)
+	}
+
+	return s
+}
+
+func convertLevel(lvl slog.Level) string {
+	switch {
+	case lvl >= slog.LevelError:
+		return "ERROR"
+	case lvl >= slog.LevelWarn:
+		return "WARN"
+	case lvl >= slog.LevelInfo:
+		return "INFO"
+	default:
+		return "DEBUG"
+	}
+}
+
+// logSpanCounter counts the log spans sent for the most recent parent spans. The oldest span is
+// evicted once the number of tracked spans exceeds the capacity.
+type logSpanCounter struct {
+	mu     sync.Mutex
+	counts map[int64]int
+	ring   []int64
+	next   int
+}
+
+func newLogSpanCounter(capacity int) *logSpanCounter {
+	return &logSpanCounter{
+		counts: make(map[int64]int, capacity),
+		ring:   make([]int64, capacity),
+	}
+}
+
+// inc increments the number of log spans sent for the parent span with given ID and returns
+// false if this number would exceed the limit
+func (c *logSpanCounter) inc(spanID int64, limit int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n, ok := c.counts[spanID]
+	if !ok {
+		// the span IDs are never 0, so an empty slot is not found in the map
+		delete(c.counts, c.ring[c.next])
+		c.ring[c.next] = spanID
+		c.next = (c.next + 1) % len(c.ring)
+	}
+
+	if n >= limit {
+		return false
+	}
+
+	c.counts[spanID] = n + 1
+
+	return true
+}
@@ -0,0 +1,222 @@
+// (c) Copyright IBM Corp. 2026
+
+package instaslog_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/acceptor"
+	"github.com/instana/go-sensor/autoprofile"
+	"github.com/instana/go-sensor/instrumentation/instaslog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CorrelationAttributes(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(instaslog.NewHandler(slog.NewJSONHandler(buf, nil), c))
+
+	sp := c.Tracer().StartSpan("testing")
+	logger.InfoContext(instana.ContextWithSpan(context.Background(), sp), "log message", "value", 42)
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	assert.Equal(t, "log message", record["msg"])
+	assert.Equal(t, float64(42), record["value"])
+	assert.Equal(t, instana.FormatID(spans[0].TraceID), record["trace_id"])
+	assert.Equal(t, instana.FormatID(spans[0].SpanID), record["span_id"])
+
+	t.Run("no span in context", func(t *testing.T) {
+		buf.Reset()
+		logger.InfoContext(context.Background(), "log message")
+
+		record = nil
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		assert.NotContains(t, record, "trace_id")
+		assert.NotContains(t, record, "span_id")
+	})
+}
+
+func TestHandler_SendLogSpans(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	logger := slog.New(instaslog.NewHandler(slog.NewTextHandler(io.Discard, nil), c)).
+		With("service", "users")
+
+	examples := map[string]struct {
+		Log                func(ctx context.Context)
+		ExpectedParameters string
+	}{
+		"ERROR": {
+			Log: func(ctx context.Context) {
+				logger.ErrorContext(ctx, "log message", "error", errors.New("connection refused"), "value", 42)
+			},
+			ExpectedParameters: `service=users error="connection refused" value=42`,
+		},
+		"WARN": {
+			Log: func(ctx context.Context) {
+				logger.WithGroup("request").WarnContext(ctx, "log message", slog.Group("user", "id", 1, "name", "John Doe"))
+			},
+			ExpectedParameters: `service=users request.user.id=1 request.user.name="John Doe"`,
+		},
+	}
+
+	for lvl, example := range examples {
+		t.Run(lvl, func(t *testing.T) {
+			parentSp := c.Tracer().StartSpan("testing")
+			example.Log(instana.ContextWithSpan(context.Background(), parentSp))
+			parentSp.Finish()
+
+			spans := recorder.GetQueuedSpans()
+			require.Len(t, spans, 2)
+
+			logSp, sp := spans[0], spans[1]
+
+			assert.Equal(t, sp.TraceID, logSp.TraceID)
+			assert.Equal(t, sp.SpanID, logSp.ParentID)
+			assert.Equal(t, "log.go", logSp.Name)
+
+			require.IsType(t, instana.LogSpanData{}, logSp.Data)
+			assert.Equal(t, instana.LogSpanTags{
+				Message: "log message",
+				Level:   lvl,
+				Error:   example.ExpectedParameters,
+			}, logSp.Data.(instana.LogSpanData).Tags)
+		})
+	}
+}
+
+func TestHandler_IgnoreLowLevels(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	logger := slog.New(instaslog.NewHandler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}), c))
+
+	parentSp := c.Tracer().StartSpan("testing")
+	ctx := instana.ContextWithSpan(context.Background(), parentSp)
+
+	logger.InfoContext(ctx, "log message")
+	logger.DebugContext(ctx, "log message")
+	parentSp.Finish()
+
+	assert.Len(t, recorder.GetQueuedSpans(), 1)
+}
+
+func TestHandler_NextHandlerLevel(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(instaslog.NewHandler(slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelError,
+	}), c))
+
+	parentSp := c.Tracer().StartSpan("testing")
+	logger.WarnContext(instana.ContextWithSpan(context.Background(), parentSp), "log message")
+	parentSp.Finish()
+
+	// the warning is sent to Instana, but discarded by the next handler
+	assert.Len(t, recorder.GetQueuedSpans(), 2)
+	assert.Empty(t, buf.String())
+}
+
+func TestHandler_MaxLogsPerSpan(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		Tracer: instana.TracerOptions{
+			MaxLogsPerSpan: 2,
+		},
+	})
+	defer instana.ShutdownCollector()
+
+	logger := slog.New(instaslog.NewHandler(slog.NewTextHandler(io.Discard, nil), c))
+
+	parentSp := c.Tracer().StartSpan("testing")
+	ctx := instana.ContextWithSpan(context.Background(), parentSp)
+
+	for i := 0; i < 3; i++ {
+		logger.ErrorContext(ctx, "log message", "attempt", i)
+	}
+	parentSp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 3)
+
+	for _, sp := range spans[:2] {
+		assert.Equal(t, "log.go", sp.Name)
+	}
+
+	// the limit is applied per parent span
+	otherSp := c.Tracer().StartSpan("testing")
+	logger.ErrorContext(instana.ContextWithSpan(context.Background(), otherSp), "log message")
+	otherSp.Finish()
+
+	assert.Len(t, recorder.GetQueuedSpans(), 2)
+}
+
+func TestHandler_LoggingDisabled(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		Tracer: instana.TracerOptions{
+			DisableSpans: map[string]bool{"logging": true},
+		},
+	})
+	defer instana.ShutdownCollector()
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(instaslog.NewHandler(slog.NewTextHandler(buf, nil), c))
+
+	parentSp := c.Tracer().StartSpan("testing")
+	logger.ErrorContext(instana.ContextWithSpan(context.Background(), parentSp), "log message")
+	parentSp.Finish()
+
+	assert.Len(t, recorder.GetQueuedSpans(), 1)
+	assert.Contains(t, buf.String(), "trace_id=")
+}
+
+type alwaysReadyClient struct{}
+
+func (alwaysReadyClient) Ready() bool                                       { return true }
+func (alwaysReadyClient) SendMetrics(data acceptor.Metrics) error           { return nil }
+func (alwaysReadyClient) SendEvent(event *instana.EventData) error          { return nil }
+func (alwaysReadyClient) SendSpans(spans []instana.Span) error              { return nil }
+func (alwaysReadyClient) SendProfiles(profiles []autoprofile.Profile) error { return nil }
+func (alwaysReadyClient) Flush(context.Context) error                       { return nil }
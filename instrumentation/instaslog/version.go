@@ -0,0 +1,6 @@
+// (c) Copyright IBM Corp. 2026
+
+package instaslog
+
+// Version is the instrumentation module semantic version
+const Version = "0.1.0"
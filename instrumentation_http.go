@@ -76,14 +76,7 @@ func TracingNamedHandlerFunc(sensor TracerLogger, routeID, pathTemplate string,
 		defer func() {
 			// Be sure to capture any kind of panic/error
 			if err := recover(); err != nil {
-				if e, ok := err.(error); ok {
-					span.SetTag("http.error", e.Error())
-					span.LogFields(otlog.Error(e))
-				} else {
-					span.SetTag("http.error", err)
-					span.LogFields(otlog.Object("error", err))
-				}
-
+				RecordPanic(span, err, "http.error")
 				span.SetTag(string(ext.HTTPStatusCode), http.StatusInternalServerError)
 
 				// re-throw the panic
@@ -175,11 +168,24 @@ func extractStartSpanOptionsFromHeaders(tracer ot.Tracer, req *http.Request, sen
 }
 
 // RoundTripper wraps an existing http.RoundTripper and injects the tracing headers into the outgoing request.
-// If the original RoundTripper is nil, the http.DefaultTransport will be used.
-func RoundTripper(sensor TracerLogger, original http.RoundTripper) http.RoundTripper {
+// If the original RoundTripper is nil, the http.DefaultTransport will be used. The collection of request phase
+// timings can be enabled by passing instana.WithHTTPTrace() option.
+//
+// The exit span is finished once the response body is read until the end or closed, so that its duration includes
+// the time spent downloading the response. Make sure to always close the response body, otherwise the span is only
+// finished after the timeout set with instana.WithResponseBodyTimeout() expires.
+func RoundTripper(sensor TracerLogger, original http.RoundTripper, opts ...RoundTripperOption) http.RoundTripper {
 	if original == nil {
 		original = http.DefaultTransport
 	}
+
+	rtOpts := &roundTripperOptions{
+		ResponseBodyTimeout: DefaultResponseBodyTimeout,
+	}
+	for _, opt := range opts {
+		opt(rtOpts)
+	}
+
 	return tracingRoundTripper(func(req *http.Request) (*http.Response, error) {
 		ctx := req.Context()
 
@@ -203,10 +209,26 @@ func RoundTripper(sensor TracerLogger, original http.RoundTripper) http.RoundTri
 		}
 
 		span := tracer.StartSpan("http", opts...)
-		defer span.Finish()
+
+		// the span of a successful call is finished once the response body is consumed
+		var body *tracedResponseBody
+		defer func() {
+			if body == nil {
+				span.Finish()
+				return
+			}
+
+			body.startTimer(rtOpts.ResponseBodyTimeout)
+		}()
 
 		// clone the request since the RoundTrip should not modify the original one
 		req = req.Clone(ContextWithSpan(ctx, span))
+
+		var clientTrace *clientTraceRecorder
+		if rtOpts.HTTPTrace {
+			clientTrace = &clientTraceRecorder{}
+			req = req.WithContext(clientTrace.withClientTrace(req.Context()))
+		}
 		sensor.Tracer().Inject(span.Context(), ot.HTTPHeaders, ot.HTTPHeadersCarrier(req.Header))
 
 		var collectableHTTPHeaders []string
@@ -235,7 +257,15 @@ func RoundTripper(sensor TracerLogger, original http.RoundTripper) http.RoundTri
 			}
 		}
 
+		if req.ContentLength > 0 {
+			span.SetTag("http.request_content_length", req.ContentLength)
+		}
+
 		resp, err := original.RoundTrip(req)
+		if clientTrace != nil {
+			clientTrace.annotate(span, resp)
+		}
+
 		if err != nil {
 			span.SetTag("http.error", err.Error())
 			span.LogFields(otlog.Error(err))
@@ -251,6 +281,15 @@ func RoundTripper(sensor TracerLogger, original http.RoundTripper) http.RoundTri
 
 		span.SetTag(string(ext.HTTPStatusCode), resp.StatusCode)
 
+		if resp.ContentLength >= 0 {
+			span.SetTag("http.response_content_length", resp.ContentLength)
+		}
+
+		if tracesResponseBody(resp) {
+			body = newTracedResponseBody(resp.Body, span, sensor.Logger())
+			resp.Body = body
+		}
+
 		return resp, err
 	})
 }
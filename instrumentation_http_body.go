@@ -0,0 +1,125 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// DefaultResponseBodyTimeout is the default time after which the HTTP client span is finished if the response
+// body has been neither read until the end nor closed
+const DefaultResponseBodyTimeout = 5 * time.Minute
+
+// WithResponseBodyTimeout sets the maximum time the HTTP client span created by instana.RoundTripper() is kept open
+// waiting for the response body to be read or closed. Once the timeout expires, the span is finished with the number
+// of bytes received so far. A zero or negative value disables the timeout, so that the span of a response, which body
+// is never closed, is never finished. The default value is DefaultResponseBodyTimeout.
+func WithResponseBodyTimeout(d time.Duration) RoundTripperOption {
+	return func(opts *roundTripperOptions) {
+		opts.ResponseBodyTimeout = d
+	}
+}
+
+// tracedResponseBody is a wrapper over the HTTP response body that finishes the client span once the body is read
+// until EOF, fails to be read or is closed, whichever happens first
+type tracedResponseBody struct {
+	io.ReadCloser
+
+	span   ot.Span
+	logger LeveledLogger
+
+	bytesRead  atomic.Int64
+	finishOnce sync.Once
+
+	mu    sync.Mutex
+	timer *time.Timer
+}
+
+func newTracedResponseBody(body io.ReadCloser, span ot.Span, logger LeveledLogger) *tracedResponseBody {
+	return &tracedResponseBody{
+		ReadCloser: body,
+		span:       span,
+		logger:     logger,
+	}
+}
+
+// Read reads the body and records the number of bytes received. A read error other than io.EOF is recorded
+// to the span.
+func (b *tracedResponseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.bytesRead.Add(int64(n))
+
+	switch err {
+	case nil:
+	case io.EOF:
+		b.finish(nil)
+	default:
+		b.finish(err)
+	}
+
+	return n, err
+}
+
+// Close closes the body and finishes the span
+func (b *tracedResponseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.finish(nil)
+
+	return err
+}
+
+// startTimer schedules the span to be finished after the timeout expires, unless the body has been read or closed
+// by then
+func (b *tracedResponseBody) startTimer(timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.timer = time.AfterFunc(timeout, func() {
+		b.logger.Warn("the HTTP response body has not been closed within ", timeout, ", finishing the client span")
+		b.finish(nil)
+	})
+}
+
+func (b *tracedResponseBody) finish(err error) {
+	b.finishOnce.Do(func() {
+		b.mu.Lock()
+		if b.timer != nil {
+			b.timer.Stop()
+		}
+		b.mu.Unlock()
+
+		if err != nil {
+			b.span.SetTag("http.error", err.Error())
+			b.span.LogFields(otlog.Error(err))
+		}
+
+		b.span.SetTag("http.response_bytes", b.bytesRead.Load())
+		b.span.Finish()
+	})
+}
+
+// tracesResponseBody returns whether the client span finishing should be deferred until the response body
+// is consumed
+func tracesResponseBody(resp *http.Response) bool {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return false
+	}
+
+	// the body of a protocol switch response is a connection used to write data as well
+	if _, ok := resp.Body.(io.Writer); ok {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,173 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTripper_StreamedResponse(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, "data: event #%d\n\n", i)
+			w.(http.Flusher).Flush()
+
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer srv.Close()
+
+	rt := instana.RoundTripper(c, srv.Client().Transport)
+
+	ctx := instana.ContextWithSpan(context.Background(), c.Tracer().StartSpan("parent"))
+	req := httptest.NewRequest(http.MethodPost, srv.URL+"/events", strings.NewReader("subscribe"))
+
+	resp, err := rt.RoundTrip(req.WithContext(ctx))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, 0, span.Ec)
+	assert.GreaterOrEqual(t, span.Duration, uint64(100))
+
+	require.IsType(t, instana.HTTPSpanData{}, span.Data)
+	data := span.Data.(instana.HTTPSpanData)
+
+	assert.Equal(t, http.StatusOK, data.Tags.Status)
+	assert.Equal(t, len("subscribe"), data.Tags.RequestContentLength)
+	assert.Zero(t, data.Tags.ResponseContentLength)
+	assert.Equal(t, len(body), data.Tags.ResponseBytes)
+}
+
+func TestRoundTripper_ResponseBodyReadError(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// the connection is closed before the declared number of bytes is sent
+		w.Header().Set("Content-Length", "10")
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	rt := instana.RoundTripper(c, srv.Client().Transport)
+
+	ctx := instana.ContextWithSpan(context.Background(), c.Tracer().StartSpan("parent"))
+	req := httptest.NewRequest(http.MethodGet, srv.URL+"/hello", nil)
+
+	resp, err := rt.RoundTrip(req.WithContext(ctx))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	_, err = io.ReadAll(resp.Body)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	var exitSpan instana.Span
+	for _, sp := range recorder.GetQueuedSpans() {
+		if sp.Name == string(instana.HTTPClientSpanType) {
+			exitSpan = sp
+		}
+	}
+
+	assert.Equal(t, 1, exitSpan.Ec)
+
+	require.IsType(t, instana.HTTPSpanData{}, exitSpan.Data)
+	data := exitSpan.Data.(instana.HTTPSpanData)
+
+	assert.Equal(t, io.ErrUnexpectedEOF.Error(), data.Tags.Error)
+	assert.Equal(t, 10, data.Tags.ResponseContentLength)
+	assert.Equal(t, 5, data.Tags.ResponseBytes)
+}
+
+func TestRoundTripper_ResponseBodyTimeout(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	rt := instana.RoundTripper(c, srv.Client().Transport, instana.WithResponseBodyTimeout(50*time.Millisecond))
+
+	ctx := instana.ContextWithSpan(context.Background(), c.Tracer().StartSpan("parent"))
+	req := httptest.NewRequest(http.MethodGet, srv.URL+"/hello", nil)
+
+	resp, err := rt.RoundTrip(req.WithContext(ctx))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Empty(t, recorder.GetQueuedSpans())
+
+	require.Eventually(t, func() bool {
+		return recorder.QueuedSpansCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// reading the body after the span has been finished does not report it again
+	_, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Len(t, recorder.GetQueuedSpans(), 1)
+}
+
+func TestRoundTripper_NoResponseBody(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	rt := instana.RoundTripper(c, srv.Client().Transport)
+
+	ctx := instana.ContextWithSpan(context.Background(), c.Tracer().StartSpan("parent"))
+	req := httptest.NewRequest(http.MethodDelete, srv.URL+"/hello", nil)
+
+	resp, err := rt.RoundTrip(req.WithContext(ctx))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// the span is finished right away, since there is nothing to read
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	require.IsType(t, instana.HTTPSpanData{}, spans[0].Data)
+	assert.Equal(t, http.StatusNoContent, spans[0].Data.(instana.HTTPSpanData).Tags.Status)
+}
@@ -0,0 +1,136 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Path templates used for the requests that did not match any pattern registered with http.ServeMux
+const (
+	NotFoundPathTemplate         = "<not found>"
+	MethodNotAllowedPathTemplate = "<method not allowed>"
+)
+
+// ServeMuxOption is an optional setting for instana.TracingServeMux()
+type ServeMuxOption func(*serveMuxOptions)
+
+type serveMuxOptions struct {
+	PathValues bool
+}
+
+// WithPathValues instructs instana.TracingServeMux() to record the values of the wildcards in the matched pattern
+// as the http.path_params tag. The values of wildcards matching the configured secrets are redacted.
+func WithPathValues() ServeMuxOption {
+	return func(opts *serveMuxOptions) {
+		opts.PathValues = true
+	}
+}
+
+// TracingServeMux returns an HTTP handler that traces the requests served by the http.ServeMux similarly
+// to instana.TracingHandlerFunc(). The path template of the span is taken from the pattern matched by the mux,
+// so that the routes do not need to be wrapped individually:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("GET /users/{id}", getUser) // reported with the http.path_tpl tag set to "/users/{id}"
+//
+//	http.ListenAndServe(":8080", instana.TracingServeMux(sensor, mux))
+//
+// The requests that did not match any pattern are reported with NotFoundPathTemplate or MethodNotAllowedPathTemplate
+// as a path template for 404 and 405 responses respectively, and are grouped into a single endpoint each instead of
+// one per requested path.
+func TracingServeMux(sensor TracerLogger, mux *http.ServeMux, opts ...ServeMuxOption) http.Handler {
+	muxOpts := &serveMuxOptions{}
+	for _, opt := range opts {
+		opt(muxOpts)
+	}
+
+	return TracingNamedHandlerFunc(sensor, "", "", func(w http.ResponseWriter, req *http.Request) {
+		// http.ServeMux stores the matched pattern and path values in the request it has been called with
+		mux.ServeHTTP(w, req)
+
+		span, ok := SpanFromContext(req.Context())
+		if !ok {
+			return
+		}
+
+		if req.Pattern == "" {
+			if wrapped, ok := w.(wrappedResponseWriter); ok {
+				switch wrapped.Status() {
+				case http.StatusNotFound:
+					span.SetTag("http.path_tpl", NotFoundPathTemplate)
+				case http.StatusMethodNotAllowed:
+					span.SetTag("http.path_tpl", MethodNotAllowedPathTemplate)
+				}
+			}
+
+			return
+		}
+
+		if pathTemplate := patternPath(req.Pattern); pathTemplate != req.URL.Path {
+			span.SetTag("http.path_tpl", pathTemplate)
+		}
+
+		if !muxOpts.PathValues {
+			return
+		}
+
+		var matcher Matcher = DefaultSecretsMatcher()
+		if t, ok := span.Tracer().(Tracer); ok {
+			matcher = t.Options().Secrets
+		}
+
+		if params := collectPathValues(req, matcher); len(params) > 0 {
+			span.SetTag("http.path_params", params.Encode())
+		}
+	})
+}
+
+// patternPath returns the path part of an http.ServeMux pattern, i.e. /users/{id} for GET example.com/users/{id}
+func patternPath(pattern string) string {
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		pattern = strings.TrimLeft(path, " \t")
+	}
+
+	if i := strings.IndexByte(pattern, '/'); i > 0 {
+		pattern = pattern[i:]
+	}
+
+	return pattern
+}
+
+// patternWildcards returns the names of the wildcards used in the path of an http.ServeMux pattern
+func patternWildcards(pattern string) []string {
+	var names []string
+	for _, segment := range strings.Split(patternPath(pattern), "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+
+		name := strings.TrimSuffix(segment[1:len(segment)-1], "...")
+		if name == "" || name == "$" {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func collectPathValues(req *http.Request, matcher Matcher) url.Values {
+	params := make(url.Values)
+
+	for _, name := range patternWildcards(req.Pattern) {
+		v := req.PathValue(name)
+		if matcher != nil && matcher.Match(name) {
+			v = "<redacted>"
+		}
+
+		params.Set(name, v)
+	}
+
+	return params
+}
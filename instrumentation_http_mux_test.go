@@ -0,0 +1,147 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracingServeMux(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintln(w, "user", req.PathValue("id"))
+	})
+	mux.HandleFunc("example.com/files/{path...}", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintln(w, "file", req.PathValue("path"))
+	})
+	mux.HandleFunc("POST /reset/{passcode}/{$}", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintln(w, "Ok")
+	})
+
+	testCases := map[string]struct {
+		Options            []instana.ServeMuxOption
+		Method             string
+		Target             string
+		ExpectedStatus     int
+		ExpectedTemplate   string
+		ExpectedPathParams string
+	}{
+		"method and wildcard": {
+			Method:           http.MethodGet,
+			Target:           "/users/42",
+			ExpectedStatus:   http.StatusOK,
+			ExpectedTemplate: "/users/{id}",
+		},
+		"method and wildcard, with path values": {
+			Options:            []instana.ServeMuxOption{instana.WithPathValues()},
+			Method:             http.MethodGet,
+			Target:             "/users/42",
+			ExpectedStatus:     http.StatusOK,
+			ExpectedTemplate:   "/users/{id}",
+			ExpectedPathParams: "id=42",
+		},
+		"host and remainder wildcard, with path values": {
+			Options:            []instana.ServeMuxOption{instana.WithPathValues()},
+			Method:             http.MethodGet,
+			Target:             "http://example.com/files/docs/README.md",
+			ExpectedStatus:     http.StatusOK,
+			ExpectedTemplate:   "/files/{path...}",
+			ExpectedPathParams: "path=docs%2FREADME.md",
+		},
+		"secret wildcard, with path values": {
+			Options:            []instana.ServeMuxOption{instana.WithPathValues()},
+			Method:             http.MethodPost,
+			Target:             "/reset/s3cr3t/",
+			ExpectedStatus:     http.StatusNoContent,
+			ExpectedTemplate:   "/reset/{passcode}/{$}",
+			ExpectedPathParams: "passcode=%3Credacted%3E",
+		},
+		"static path": {
+			Options:        []instana.ServeMuxOption{instana.WithPathValues()},
+			Method:         http.MethodGet,
+			Target:         "/health",
+			ExpectedStatus: http.StatusOK,
+		},
+		"not found": {
+			Method:           http.MethodGet,
+			Target:           "/articles/1",
+			ExpectedStatus:   http.StatusNotFound,
+			ExpectedTemplate: instana.NotFoundPathTemplate,
+		},
+		"method not allowed": {
+			Method:           http.MethodDelete,
+			Target:           "/users/42",
+			ExpectedStatus:   http.StatusMethodNotAllowed,
+			ExpectedTemplate: instana.MethodNotAllowedPathTemplate,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			recorder := instana.NewTestRecorder()
+			c := instana.InitCollector(&instana.Options{
+				Service:     "go-sensor-test",
+				AgentClient: alwaysReadyClient{},
+				Recorder:    recorder,
+			})
+			defer instana.ShutdownCollector()
+
+			h := instana.TracingServeMux(c, mux, testCase.Options...)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(testCase.Method, testCase.Target, nil))
+
+			assert.Equal(t, testCase.ExpectedStatus, rec.Code)
+
+			spans := recorder.GetQueuedSpans()
+			require.Len(t, spans, 1)
+
+			span := spans[0]
+			assert.Equal(t, 0, span.Ec)
+
+			require.IsType(t, instana.HTTPSpanData{}, span.Data)
+			data := span.Data.(instana.HTTPSpanData)
+
+			assert.Equal(t, testCase.ExpectedStatus, data.Tags.Status)
+			assert.Equal(t, testCase.ExpectedTemplate, data.Tags.PathTemplate)
+			assert.Equal(t, testCase.ExpectedPathParams, data.Tags.PathParams)
+		})
+	}
+}
+
+func TestTracingServeMux_PropagatesSpan(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     "go-sensor-test",
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	var handlerSpanID int64
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, req *http.Request) {
+		sp, ok := instana.SpanFromContext(req.Context())
+		require.True(t, ok)
+
+		handlerSpanID = sp.Context().(instana.SpanContext).SpanID
+	})
+
+	instana.TracingServeMux(c, mux).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42", nil))
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, spans[0].SpanID, handlerSpanID)
+}
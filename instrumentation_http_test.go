@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -523,10 +524,13 @@ func TestTracingHandlerFunc_PanicHandling(t *testing.T) {
 	require.IsType(t, instana.LogSpanData{}, logSpan.Data)
 	logData := logSpan.Data.(instana.LogSpanData)
 
-	assert.Equal(t, instana.LogSpanTags{
-		Level:   "ERROR",
-		Message: `error: "something went wrong"`,
-	}, logData.Tags)
+	assert.Equal(t, "ERROR", logData.Tags.Level)
+	assert.True(t, strings.HasPrefix(logData.Tags.Message,
+		`error: "something went wrong" panic.type: "string" stack: "github.com/instana/go-sensor_test.TestTracingHandlerFunc_PanicHandling.func1\n\t`),
+		logData.Tags.Message)
+
+	require.NotEmpty(t, span.Stack)
+	assert.Equal(t, "github.com/instana/go-sensor_test.TestTracingHandlerFunc_PanicHandling.func1", span.Stack[0].Method)
 }
 
 func TestRoundTripper(t *testing.T) {
@@ -745,6 +749,14 @@ func TestRoundTripper_DefaultTransport(t *testing.T) {
 
 	assert.Equal(t, 1, numCalls)
 
+	// the span is finished once the response body is consumed
+	assert.Empty(t, recorder.GetQueuedSpans())
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", string(body))
+	require.NoError(t, resp.Body.Close())
+
 	spans := recorder.GetQueuedSpans()
 	require.Len(t, spans, 1)
 
@@ -756,9 +768,11 @@ func TestRoundTripper_DefaultTransport(t *testing.T) {
 	data := span.Data.(instana.HTTPSpanData)
 
 	assert.Equal(t, instana.HTTPSpanTags{
-		Status: http.StatusOK,
-		Method: "GET",
-		URL:    ts.URL + "/hello",
+		Status:                http.StatusOK,
+		Method:                "GET",
+		URL:                   ts.URL + "/hello",
+		ResponseContentLength: 2,
+		ResponseBytes:         2,
 	}, data.Tags)
 }
 
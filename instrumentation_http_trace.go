@@ -0,0 +1,167 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// RoundTripperOption is an optional setting for instana.RoundTripper()
+type RoundTripperOption func(*roundTripperOptions)
+
+type roundTripperOptions struct {
+	HTTPTrace           bool
+	ResponseBodyTimeout time.Duration
+}
+
+// WithHTTPTrace instructs instana.RoundTripper() to collect the durations of the request phases using
+// net/http/httptrace. In addition to the timings, the exit span is annotated with the remote IP address, the
+// negotiated protocol version and whether an idle connection has been reused to send the request.
+func WithHTTPTrace() RoundTripperOption {
+	return func(opts *roundTripperOptions) {
+		opts.HTTPTrace = true
+	}
+}
+
+// clientTraceRecorder collects the timestamps of the HTTP client request phases reported by httptrace.ClientTrace.
+// The hooks may be called concurrently, i.e. while the transport dials a new connection in a separate goroutine.
+type clientTraceRecorder struct {
+	mu sync.Mutex
+
+	getConn, gotConn          time.Time
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	wroteRequest, firstByte   time.Time
+	reused                    bool
+	remoteIP                  string
+}
+
+// withClientTrace returns a context that calls the hooks of the recorder in addition to the client trace hooks
+// found in ctx, if any
+func (rec *clientTraceRecorder) withClientTrace(ctx context.Context) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GetConn: func(string) {
+			rec.record(&rec.getConn)
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+
+			rec.gotConn = time.Now()
+			rec.reused = info.Reused
+
+			if info.Conn == nil {
+				return
+			}
+
+			if host, _, err := net.SplitHostPort(info.Conn.RemoteAddr().String()); err == nil {
+				rec.remoteIP = host
+			}
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			rec.record(&rec.dnsStart)
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			rec.record(&rec.dnsDone)
+		},
+		ConnectStart: func(string, string) {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+
+			// the transport may try several addresses, the first attempt marks the beginning of the phase
+			if rec.connectStart.IsZero() {
+				rec.connectStart = time.Now()
+			}
+		},
+		ConnectDone: func(string, string, error) {
+			rec.record(&rec.connectDone)
+		},
+		TLSHandshakeStart: func() {
+			rec.record(&rec.tlsStart)
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			rec.record(&rec.tlsDone)
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			rec.record(&rec.wroteRequest)
+		},
+		GotFirstResponseByte: func() {
+			rec.record(&rec.firstByte)
+		},
+	})
+}
+
+func (rec *clientTraceRecorder) record(ts *time.Time) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	*ts = time.Now()
+}
+
+// annotate sets the collected phase durations and connection details as tags of the span
+func (rec *clientTraceRecorder) annotate(span ot.Span, resp *http.Response) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if resp != nil && resp.Proto != "" {
+		span.SetTag("http.proto", resp.Proto)
+	}
+
+	if rec.gotConn.IsZero() {
+		return
+	}
+
+	span.SetTag("http.conn_reused", rec.reused)
+
+	if rec.remoteIP != "" {
+		span.SetTag("http.remote_ip", rec.remoteIP)
+	}
+
+	dns := phaseDuration(rec.dnsStart, rec.dnsDone)
+	connect := phaseDuration(rec.connectStart, rec.connectDone)
+	tlsHandshake := phaseDuration(rec.tlsStart, rec.tlsDone)
+
+	// the time spent waiting for a connection from the pool, excluding the time needed to establish a new one
+	poolWait := phaseDuration(rec.getConn, rec.gotConn) - dns - connect - tlsHandshake
+	if poolWait < 0 {
+		poolWait = 0
+	}
+
+	span.SetTag("http.timing.pool_wait", durationMillis(poolWait))
+
+	if !rec.reused {
+		span.SetTag("http.timing.dns", durationMillis(dns))
+		span.SetTag("http.timing.connect", durationMillis(connect))
+
+		if !rec.tlsStart.IsZero() {
+			span.SetTag("http.timing.tls", durationMillis(tlsHandshake))
+		}
+	}
+
+	if !rec.firstByte.IsZero() {
+		span.SetTag("http.timing.server", durationMillis(phaseDuration(rec.wroteRequest, rec.firstByte)))
+	}
+}
+
+// phaseDuration returns the duration of a phase or 0 if it has not been started or completed
+func phaseDuration(start, end time.Time) time.Duration {
+	if start.IsZero() || end.IsZero() || end.Before(start) {
+		return 0
+	}
+
+	return end.Sub(start)
+}
+
+// durationMillis converts the duration into milliseconds keeping the sub-millisecond precision
+func durationMillis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
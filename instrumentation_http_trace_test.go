@@ -0,0 +1,151 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTripper_WithHTTPTrace(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     TestServiceName,
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintln(w, "Ok")
+	}))
+	defer srv.Close()
+
+	client := &http.Client{
+		Transport: instana.RoundTripper(c, srv.Client().Transport, instana.WithHTTPTrace()),
+	}
+
+	parentSpan := c.StartSpan("parent")
+	ctx := instana.ContextWithSpan(context.Background(), parentSpan)
+
+	// the second request is expected to reuse the connection established by the first one
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/hello", nil)
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+
+		_, err = io.Copy(io.Discard, resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	parentSpan.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 3)
+
+	require.IsType(t, instana.HTTPSpanData{}, spans[0].Data)
+	first := spans[0].Data.(instana.HTTPSpanData).Tags
+
+	assert.Equal(t, "HTTP/1.1", first.ProtocolVersion)
+	assert.Equal(t, "127.0.0.1", first.RemoteIP)
+	assert.False(t, first.ConnReused)
+	require.NotNil(t, first.Timings)
+	assert.Greater(t, first.Timings.Connect, 0.0)
+	assert.Zero(t, first.Timings.TLS)
+
+	require.IsType(t, instana.HTTPSpanData{}, spans[1].Data)
+	second := spans[1].Data.(instana.HTTPSpanData).Tags
+
+	assert.Equal(t, "HTTP/1.1", second.ProtocolVersion)
+	assert.Equal(t, "127.0.0.1", second.RemoteIP)
+	assert.True(t, second.ConnReused)
+	require.NotNil(t, second.Timings)
+	assert.Zero(t, second.Timings.Connect)
+	assert.Zero(t, second.Timings.DNS)
+}
+
+func TestRoundTripper_WithHTTPTrace_HTTP2(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     TestServiceName,
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintln(w, "Ok")
+	}))
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	rt := instana.RoundTripper(c, srv.Client().Transport, instana.WithHTTPTrace())
+
+	parentSpan := c.StartSpan("parent")
+
+	req := httptest.NewRequest(http.MethodGet, srv.URL+"/hello", nil)
+	resp, err := rt.RoundTrip(req.WithContext(instana.ContextWithSpan(context.Background(), parentSpan)))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	parentSpan.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	require.IsType(t, instana.HTTPSpanData{}, spans[0].Data)
+	tags := spans[0].Data.(instana.HTTPSpanData).Tags
+
+	assert.Equal(t, "HTTP/2.0", tags.ProtocolVersion)
+	assert.Equal(t, "127.0.0.1", tags.RemoteIP)
+	require.NotNil(t, tags.Timings)
+	assert.Greater(t, tags.Timings.TLS, 0.0)
+}
+
+func TestRoundTripper_WithoutHTTPTrace(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     TestServiceName,
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintln(w, "Ok")
+	}))
+	defer srv.Close()
+
+	rt := instana.RoundTripper(c, srv.Client().Transport)
+
+	parentSpan := c.StartSpan("parent")
+
+	req := httptest.NewRequest(http.MethodGet, srv.URL+"/hello", nil)
+	resp, err := rt.RoundTrip(req.WithContext(instana.ContextWithSpan(context.Background(), parentSpan)))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	parentSpan.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	require.IsType(t, instana.HTTPSpanData{}, spans[0].Data)
+	tags := spans[0].Data.(instana.HTTPSpanData).Tags
+
+	assert.Empty(t, tags.ProtocolVersion)
+	assert.Empty(t, tags.RemoteIP)
+	assert.Nil(t, tags.Timings)
+}
@@ -20,6 +20,8 @@ import (
 
 var (
 	sqlDriverRegistrationMu sync.Mutex
+	// instrumentedSQLDrivers holds the drivers registered by InstrumentSQLDriver() by their instrumented name
+	instrumentedSQLDrivers = make(map[string]*wrappedSQLDriver)
 )
 
 // sqlSpanData contains the data for creating a sql db span
@@ -135,22 +137,47 @@ func InstrumentSQLDriver(sensor TracerLogger, name string, driver driver.Driver)
 		}
 	}
 
-	sql.Register(instrumentedName, &wrappedSQLDriver{
+	drv := &wrappedSQLDriver{
 		Driver:     driver,
 		driverName: name,
 		sensor:     sensor,
-	})
+	}
+
+	sql.Register(instrumentedName, drv)
+	instrumentedSQLDrivers[instrumentedName] = drv
 }
 
 // SQLOpen is a convenience wrapper for `sql.Open()` to use the instrumented version
-// of a driver previously registered using `instana.InstrumentSQLDriver()`
+// of a driver previously registered using `instana.InstrumentSQLDriver()`. The connection pool
+// statistics of the returned database are reported along with the process metrics until it is closed.
 func SQLOpen(driverName, dataSourceName string) (*sql.DB, error) {
 
 	if !strings.HasSuffix(driverName, "_with_instana") {
 		driverName += "_with_instana"
 	}
 
-	return sql.Open(driverName, dataSourceName)
+	sqlDriverRegistrationMu.Lock()
+	drv, ok := instrumentedSQLDrivers[driverName]
+	sqlDriverRegistrationMu.Unlock()
+
+	if !ok {
+		return sql.Open(driverName, dataSourceName)
+	}
+
+	// this is what sql.Open() does for a driver.DriverContext, however the connector needs to be kept
+	// to unregister the connection pool once the database is closed
+	connector, err := drv.OpenConnector(dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	db := sql.OpenDB(connector)
+
+	if wc, ok := connector.(*wrappedSQLConnector); ok {
+		wc.unregisterPool = RegisterConnectionPool(getDBConnDetails(dataSourceName, drv.driverName), sqlDBPoolStats(db))
+	}
+
+	return db, nil
 }
 
 //go:linkname drivers database/sql.drivers
@@ -213,7 +240,7 @@ func (s *sqlSpanData) postgresSpan(
 	s.addTag("pg.stmt", s.query)
 
 	opts := []ot.StartSpanOption{ext.SpanKindRPCClient, s.tags}
-	if parentSpan, ok := SpanFromContext(ctx); ok {
+	if parentSpan, ok := s.parentSpan(ctx); ok {
 		opts = append(opts, ot.ChildOf(parentSpan.Context()))
 	}
 
@@ -228,7 +255,7 @@ func (s *sqlSpanData) mySQLSpan(
 	s.addTag("mysql.stmt", s.query)
 
 	opts := []ot.StartSpanOption{ext.SpanKindRPCClient, s.tags}
-	if parentSpan, ok := SpanFromContext(ctx); ok {
+	if parentSpan, ok := s.parentSpan(ctx); ok {
 		opts = append(opts, ot.ChildOf(parentSpan.Context()))
 	}
 
@@ -243,7 +270,7 @@ func (s *sqlSpanData) redisSpan(
 	s.addTag("redis.command", cmd)
 
 	opts := []ot.StartSpanOption{ext.SpanKindRPCClient, s.tags}
-	if parentSpan, ok := SpanFromContext(ctx); ok {
+	if parentSpan, ok := s.parentSpan(ctx); ok {
 		opts = append(opts, ot.ChildOf(parentSpan.Context()))
 	}
 
@@ -257,7 +284,7 @@ func (s *sqlSpanData) couchbaseSpan(
 	s.addTag("couchbase.sql", s.query)
 
 	opts := []ot.StartSpanOption{ext.SpanKindRPCClient, s.tags}
-	if parentSpan, ok := SpanFromContext(ctx); ok {
+	if parentSpan, ok := s.parentSpan(ctx); ok {
 		opts = append(opts, ot.ChildOf(parentSpan.Context()))
 	}
 
@@ -271,7 +298,7 @@ func (s *sqlSpanData) cosmosSpan(
 	s.addTag("cosmos.cmd", s.query)
 
 	opts := []ot.StartSpanOption{ext.SpanKindRPCClient, s.tags}
-	if parentSpan, ok := SpanFromContext(ctx); ok {
+	if parentSpan, ok := s.parentSpan(ctx); ok {
 		opts = append(opts, ot.ChildOf(parentSpan.Context()))
 	}
 
@@ -284,14 +311,29 @@ func (s *sqlSpanData) genericSQLSpan(
 
 	s.addTag(string(ext.DBStatement), s.query)
 
+	// pass the dialect of the databases that do not have a registered span type to the statement normalizer
+	if dialect := sqlDialectByName(s.connDetails.DatabaseName); dialect != GenericSQLDialect {
+		s.addTag(sqlDialectTag, dialect.String())
+	}
+
 	opts := []ot.StartSpanOption{ext.SpanKindRPCClient, s.tags}
-	if parentSpan, ok := SpanFromContext(ctx); ok {
+	if parentSpan, ok := s.parentSpan(ctx); ok {
 		opts = append(opts, ot.ChildOf(parentSpan.Context()))
 	}
 
 	return sensor.StartSpan("sdk.database", opts...)
 }
 
+// parentSpan returns the span of the transaction in progress on the connection, so that the statements executed
+// within a transaction are reported as its children. Otherwise, the span found in ctx is returned.
+func (s *sqlSpanData) parentSpan(ctx context.Context) (ot.Span, bool) {
+	if sp, ok := s.connDetails.tx.span(); ok {
+		return sp, true
+	}
+
+	return SpanFromContext(ctx)
+}
+
 // parseDatabaseFromQuery attempts to guess what is the database based on the query
 func (s *sqlSpanData) parseDatabaseFromQuery() {
 	s.updateSpanDataIfRedis()
@@ -360,6 +402,9 @@ type DbConnDetails struct {
 	User         string
 	DatabaseName string
 	Error        error
+
+	// tx holds the transaction started on a wrapped connection, if any
+	tx *sqlTxState
 }
 
 // ParseDBConnDetails parses a database connection string (connStr) and returns a DbConnDetails struct.
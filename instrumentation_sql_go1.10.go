@@ -6,6 +6,7 @@ package instana
 import (
 	"context"
 	"database/sql/driver"
+	"io"
 )
 
 type wrappedSQLConnector struct {
@@ -13,6 +14,9 @@ type wrappedSQLConnector struct {
 
 	connDetails DbConnDetails
 	sensor      TracerLogger
+
+	// unregisterPool removes the connection pool of the database opened with this connector from the pool metrics
+	unregisterPool func()
 }
 
 // WrapSQLConnector wraps an existing sql.Connector and instruments the DB calls made using it
@@ -43,6 +47,20 @@ func (c *wrappedSQLConnector) Connect(ctx context.Context) (driver.Conn, error)
 	return w, nil
 }
 
+// Close is called by database/sql once the database is closed. It stops reporting the connection pool metrics
+// and closes the original connector if it implements io.Closer.
+func (c *wrappedSQLConnector) Close() error {
+	if c.unregisterPool != nil {
+		c.unregisterPool()
+	}
+
+	if closer, ok := c.Connector.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
 func (c *wrappedSQLConnector) Driver() driver.Driver {
 	if drv, ok := c.Connector.Driver().(*wrappedSQLDriver); ok {
 		return drv
@@ -57,11 +57,12 @@ func TestWrapSQLConnector_Exec(t *testing.T) {
 		Type: "exit",
 		Custom: map[string]interface{}{
 			"tags": ot.Tags{
-				"span.kind":    ext.SpanKindRPCClientEnum,
-				"db.instance":  "connection string",
-				"db.statement": "TEST QUERY",
-				"db.type":      "sql",
-				"peer.address": "connection string",
+				"span.kind":        ext.SpanKindRPCClientEnum,
+				"db.instance":      "connection string",
+				"db.statement":     "TEST QUERY",
+				"db.rows_affected": int64(100),
+				"db.type":          "sql",
+				"peer.address":     "connection string",
 			},
 		},
 	}, data.Tags)
@@ -141,6 +142,8 @@ func TestWrapSQLConnector_Query(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, []string{"col1", "col2"}, cols)
 
+	require.NoError(t, res.Close())
+
 	spans := recorder.GetQueuedSpans()
 	require.Len(t, spans, 1)
 
@@ -159,6 +162,7 @@ func TestWrapSQLConnector_Query(t *testing.T) {
 				"span.kind":    ext.SpanKindRPCClientEnum,
 				"db.instance":  "connection string",
 				"db.statement": "TEST QUERY",
+				"db.rows":      0,
 				"db.type":      "sql",
 				"peer.address": "connection string",
 			},
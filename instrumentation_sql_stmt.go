@@ -28,6 +28,10 @@ func (stmt *wStmt) Exec(args []driver.Value) (driver.Result, error) {
 		sp.SetTag(dbKey+".error", err.Error())
 	}
 
+	if err == nil {
+		setRowsAffected(sp, dbKey, res)
+	}
+
 	return res, err
 }
 
@@ -35,13 +39,19 @@ func (stmt *wStmt) Query(args []driver.Value) (driver.Rows, error) {
 	ctx := context.Background()
 
 	sp, dbKey := stmt.sqlSpan.start(ctx, stmt.sensor)
-	defer sp.Finish()
 
 	res, err := stmt.Stmt.Query(args) //nolint:staticcheck
-	if err != nil && err != driver.ErrSkip {
-		sp.LogFields(otlog.Error(err))
-		sp.SetTag(dbKey+".error", err.Error())
+	if err != nil {
+		if err != driver.ErrSkip {
+			sp.LogFields(otlog.Error(err))
+			sp.SetTag(dbKey+".error", err.Error())
+		}
+
+		sp.Finish()
+
+		return res, err
 	}
 
-	return res, err
+	// the span is finished once the rows are closed
+	return wrapRows(res, sp, dbKey), nil
 }
@@ -8,8 +8,10 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"sync"
 	"testing"
 	_ "unsafe"
 
@@ -35,6 +37,39 @@ func TestInstrumentSQLDriver(t *testing.T) {
 	})
 }
 
+func TestSQLOpen_ConcurrentRegistration(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     "go-sensor-test",
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	instana.InstrumentSQLDriver(c, "test_concurrent_open_driver", sqlDriver{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func(i int) {
+			defer wg.Done()
+			instana.InstrumentSQLDriver(c, fmt.Sprintf("test_concurrent_register_driver_%d", i), sqlDriver{})
+		}(i)
+
+		go func() {
+			defer wg.Done()
+
+			db, err := instana.SQLOpen("test_concurrent_open_driver", "connection string")
+			if assert.NoError(t, err) {
+				assert.NoError(t, db.Close())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
 func BenchmarkSQLOpenAndExec(b *testing.B) {
 	recorder := instana.NewTestRecorder()
 	c := instana.InitCollector(&instana.Options{
@@ -103,11 +138,12 @@ func TestOpenSQLDB_WithoutParentSpan(t *testing.T) {
 			Type: "exit",
 			Custom: map[string]interface{}{
 				"tags": ot.Tags{
-					"span.kind":    ext.SpanKindRPCClientEnum,
-					"db.instance":  "connection string",
-					"db.statement": "TEST QUERY",
-					"db.type":      "sql",
-					"peer.address": "connection string",
+					"span.kind":        ext.SpanKindRPCClientEnum,
+					"db.instance":      "connection string",
+					"db.statement":     "TEST QUERY",
+					"db.rows_affected": int64(100),
+					"db.type":          "sql",
+					"peer.address":     "connection string",
 				},
 			},
 		}, data.Tags)
@@ -121,6 +157,8 @@ func TestOpenSQLDB_WithoutParentSpan(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, []string{"col1", "col2"}, cols)
 
+		require.NoError(t, res.Close())
+
 		spans := recorder.GetQueuedSpans()
 		require.Len(t, spans, 1)
 
@@ -139,6 +177,7 @@ func TestOpenSQLDB_WithoutParentSpan(t *testing.T) {
 					"span.kind":    ext.SpanKindRPCClientEnum,
 					"db.instance":  "connection string",
 					"db.statement": "TEST QUERY",
+					"db.rows":      0,
 					"db.type":      "sql",
 					"peer.address": "connection string",
 				},
@@ -191,11 +230,12 @@ func TestOpenSQLDB(t *testing.T) {
 			Type: "exit",
 			Custom: map[string]interface{}{
 				"tags": ot.Tags{
-					"span.kind":    ext.SpanKindRPCClientEnum,
-					"db.instance":  "connection string",
-					"db.statement": "TEST QUERY",
-					"db.type":      "sql",
-					"peer.address": "connection string",
+					"span.kind":        ext.SpanKindRPCClientEnum,
+					"db.instance":      "connection string",
+					"db.statement":     "TEST QUERY",
+					"db.rows_affected": int64(100),
+					"db.type":          "sql",
+					"peer.address":     "connection string",
 				},
 			},
 		}, data.Tags)
@@ -209,6 +249,8 @@ func TestOpenSQLDB(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, []string{"col1", "col2"}, cols)
 
+		require.NoError(t, res.Close())
+
 		spans := recorder.GetQueuedSpans()
 		require.Len(t, spans, 1)
 
@@ -227,6 +269,7 @@ func TestOpenSQLDB(t *testing.T) {
 					"span.kind":    ext.SpanKindRPCClientEnum,
 					"db.instance":  "connection string",
 					"db.statement": "TEST QUERY",
+					"db.rows":      0,
 					"db.type":      "sql",
 					"peer.address": "connection string",
 				},
@@ -273,12 +316,13 @@ func TestPostgresDB(t *testing.T) {
 				assert.Equal(t, int64(42), lastID)
 			},
 			want: instana.PostgreSQLSpanTags{
-				Host:  "1.2.3.4,2.3.4.5",
-				DB:    "test-schema",
-				Port:  "1234",
-				User:  "user1",
-				Stmt:  "TEST QUERY",
-				Error: "",
+				Host:         "1.2.3.4,2.3.4.5",
+				DB:           "test-schema",
+				Port:         "1234",
+				User:         "user1",
+				Stmt:         "TEST QUERY",
+				RowsAffected: 100,
+				Error:        "",
 			},
 		},
 		{
@@ -290,6 +334,8 @@ func TestPostgresDB(t *testing.T) {
 				cols, err := res.Columns()
 				require.NoError(t, err)
 				assert.Equal(t, []string{"col1", "col2"}, cols)
+
+				require.NoError(t, res.Close())
 			},
 			want: instana.PostgreSQLSpanTags{
 				Host:  "1.2.3.4,2.3.4.5",
@@ -479,13 +525,14 @@ func TestOpenDB2(t *testing.T) {
 				Type: "exit",
 				Custom: map[string]interface{}{
 					"tags": ot.Tags{
-						"span.kind":     ext.SpanKindRPCClientEnum,
-						"db.instance":   "sample",
-						"db.statement":  "TEST QUERY",
-						"db.type":       "sql",
-						"peer.address":  "Server=localhost:50000;DATABASE=sample;UID=db2inst1;",
-						"peer.hostname": "localhost",
-						"peer.port":     "50000",
+						"span.kind":        ext.SpanKindRPCClientEnum,
+						"db.instance":      "sample",
+						"db.statement":     "TEST QUERY",
+						"db.rows_affected": int64(100),
+						"db.type":          "sql",
+						"peer.address":     "Server=localhost:50000;DATABASE=sample;UID=db2inst1;",
+						"peer.hostname":    "localhost",
+						"peer.port":        "50000",
 					},
 				},
 			},
@@ -497,12 +544,13 @@ func TestOpenDB2(t *testing.T) {
 				Type: "exit",
 				Custom: map[string]interface{}{
 					"tags": ot.Tags{
-						"span.kind":     ext.SpanKindRPCClientEnum,
-						"db.instance":   "sample",
-						"db.statement":  "TEST QUERY",
-						"db.type":       "sql",
-						"peer.address":  "Server=localhost;DATABASE=sample;UID=db2inst1;",
-						"peer.hostname": "localhost",
+						"span.kind":        ext.SpanKindRPCClientEnum,
+						"db.instance":      "sample",
+						"db.statement":     "TEST QUERY",
+						"db.rows_affected": int64(100),
+						"db.type":          "sql",
+						"peer.address":     "Server=localhost;DATABASE=sample;UID=db2inst1;",
+						"peer.hostname":    "localhost",
 					},
 				},
 			},
@@ -514,13 +562,14 @@ func TestOpenDB2(t *testing.T) {
 				Type: "exit",
 				Custom: map[string]interface{}{
 					"tags": ot.Tags{
-						"span.kind":     ext.SpanKindRPCClientEnum,
-						"db.instance":   "sample",
-						"db.statement":  "TEST QUERY",
-						"db.type":       "sql",
-						"peer.address":  "Hostname=localhost;Port=50000;DATABASE=sample;UID=db2inst1;",
-						"peer.hostname": "localhost",
-						"peer.port":     "50000",
+						"span.kind":        ext.SpanKindRPCClientEnum,
+						"db.instance":      "sample",
+						"db.statement":     "TEST QUERY",
+						"db.rows_affected": int64(100),
+						"db.type":          "sql",
+						"peer.address":     "Hostname=localhost;Port=50000;DATABASE=sample;UID=db2inst1;",
+						"peer.hostname":    "localhost",
+						"peer.port":        "50000",
 					},
 				},
 			},
@@ -583,12 +632,13 @@ func TestOpenDB2(t *testing.T) {
 		data := span.Data.(instana.MySQLSpanData)
 
 		assert.Equal(t, instana.MySQLSpanTags{
-			Host:  "localhost:50000",
-			Port:  "",
-			DB:    "sample",
-			User:  "db2inst1",
-			Stmt:  "TEST QUERY",
-			Error: "",
+			Host:         "localhost:50000",
+			Port:         "",
+			DB:           "sample",
+			User:         "db2inst1",
+			Stmt:         "TEST QUERY",
+			RowsAffected: 100,
+			Error:        "",
 		}, data.Tags)
 	})
 }
@@ -760,13 +810,14 @@ func TestOpenSQLDB_URIConnString(t *testing.T) {
 		Type: "exit",
 		Custom: map[string]interface{}{
 			"tags": ot.Tags{
-				"span.kind":     ext.SpanKindRPCClientEnum,
-				"db.instance":   "test-schema",
-				"db.statement":  "TEST QUERY",
-				"db.type":       "sql",
-				"peer.address":  "db://user1@db-host:1234/test-schema?param=value",
-				"peer.hostname": "db-host",
-				"peer.port":     "1234",
+				"span.kind":        ext.SpanKindRPCClientEnum,
+				"db.instance":      "test-schema",
+				"db.statement":     "TEST QUERY",
+				"db.rows_affected": int64(100),
+				"db.type":          "sql",
+				"peer.address":     "db://user1@db-host:1234/test-schema?param=value",
+				"peer.hostname":    "db-host",
+				"peer.port":        "1234",
 			},
 		},
 	}, data.Tags)
@@ -804,12 +855,13 @@ func TestOpenSQLDB_PostgresKVConnString(t *testing.T) {
 	data := spans[0].Data.(instana.PostgreSQLSpanData)
 
 	assert.Equal(t, instana.PostgreSQLSpanTags{
-		Host:  "1.2.3.4,2.3.4.5",
-		DB:    "test-schema",
-		Port:  "1234",
-		User:  "user1",
-		Stmt:  "TEST QUERY",
-		Error: "",
+		Host:         "1.2.3.4,2.3.4.5",
+		DB:           "test-schema",
+		Port:         "1234",
+		User:         "user1",
+		Stmt:         "TEST QUERY",
+		RowsAffected: 100,
+		Error:        "",
 	}, data.Tags)
 }
 
@@ -845,12 +897,13 @@ func TestOpenSQLDB_MySQLKVConnString(t *testing.T) {
 	data := spans[0].Data.(instana.MySQLSpanData)
 
 	assert.Equal(t, instana.MySQLSpanTags{
-		Host:  "db-host1, db-host2",
-		Port:  "1234",
-		DB:    "test-schema",
-		User:  "user1",
-		Stmt:  "TEST QUERY",
-		Error: "",
+		Host:         "db-host1, db-host2",
+		Port:         "1234",
+		DB:           "test-schema",
+		User:         "user1",
+		Stmt:         "TEST QUERY",
+		RowsAffected: 100,
+		Error:        "",
 	}, data.Tags)
 }
 
@@ -917,8 +970,9 @@ func TestConnPrepareContext(t *testing.T) {
 	stmt, err := db.PrepareContext(ctx, "select 1 from table")
 	require.NoError(t, err)
 
-	_, err = stmt.QueryContext(ctx)
+	rows, err := stmt.QueryContext(ctx)
 	require.NoError(t, err)
+	require.NoError(t, rows.Close())
 
 	spans := recorder.GetQueuedSpans()
 
@@ -935,6 +989,7 @@ func TestConnPrepareContext(t *testing.T) {
 				"span.kind":    ext.SpanKindRPCClientEnum,
 				"db.instance":  "conn string",
 				"db.statement": "select 1 from table",
+				"db.rows":      0,
 				"db.type":      "sql",
 				"peer.address": "conn string",
 			},
@@ -1035,11 +1090,12 @@ func TestStmtExecContext(t *testing.T) {
 		Type: "exit",
 		Custom: map[string]interface{}{
 			"tags": ot.Tags{
-				"span.kind":    ext.SpanKindRPCClientEnum,
-				"db.instance":  "conn string",
-				"db.statement": "select 1 from table",
-				"db.type":      "sql",
-				"peer.address": "conn string",
+				"span.kind":        ext.SpanKindRPCClientEnum,
+				"db.instance":      "conn string",
+				"db.statement":     "select 1 from table",
+				"db.rows_affected": int64(100),
+				"db.type":          "sql",
+				"peer.address":     "conn string",
 			},
 		},
 	}, data.Tags)
@@ -1280,11 +1336,12 @@ func TestStmtExecContext_WithRedisCommands(t *testing.T) {
 			Type: "exit",
 			Custom: map[string]interface{}{
 				"tags": ot.Tags{
-					"span.kind":    ext.SpanKindRPCClientEnum,
-					"db.instance":  "192.168.2.10:6790",
-					"db.statement": "SELECT key",
-					"db.type":      "sql",
-					"peer.address": "192.168.2.10:6790",
+					"span.kind":        ext.SpanKindRPCClientEnum,
+					"db.instance":      "192.168.2.10:6790",
+					"db.statement":     "SELECT key",
+					"db.rows_affected": int64(100),
+					"db.type":          "sql",
+					"peer.address":     "192.168.2.10:6790",
 				},
 			},
 		}, data.Tags)
@@ -1311,11 +1368,12 @@ func TestStmtExecContext_WithRedisCommands(t *testing.T) {
 			Type: "exit",
 			Custom: map[string]interface{}{
 				"tags": ot.Tags{
-					"span.kind":    ext.SpanKindRPCClientEnum,
-					"db.instance":  "192.168.2.10:6790",
-					"db.statement": "",
-					"db.type":      "sql",
-					"peer.address": "192.168.2.10:6790",
+					"span.kind":        ext.SpanKindRPCClientEnum,
+					"db.instance":      "192.168.2.10:6790",
+					"db.statement":     "",
+					"db.rows_affected": int64(100),
+					"db.type":          "sql",
+					"peer.address":     "192.168.2.10:6790",
 				},
 			},
 		}, data.Tags)
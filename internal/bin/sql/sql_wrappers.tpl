@@ -36,6 +36,9 @@ func connAlreadyWrapped(conn driver.Conn) bool {
 
 // wrapConn wraps the matching type around the driver.Conn based on which interfaces the driver implements
 func wrapConn(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger) driver.Conn {
+	// the transaction state is shared by all wrappers created for this connection
+	connDetails.tx = &sqlTxState{}
+
 	{{range connInterfaces -}}
 	{{replace . "driver." ""}}, is{{replace . "driver." ""}} := conn.({{.}})
 	{{end -}}
@@ -68,7 +71,7 @@ func get_{{.TypeName}}(connDetails DbConnDetails, conn driver.Conn, sensor Trace
 			{{$theType := replace . "driver." ""}}
 			{{- if eq $theType "NamedValueChecker"}} NamedValueChecker: NamedValueChecker,
 			{{- else if eq $theType "ColumnConverter"}} cc: ColumnConverter,
-			{{- else if eq $theType "ConnPrepareContext"}}
+			{{- else if or (eq $theType "ConnPrepareContext") (eq $theType "ConnBeginTx")}}
 			{{$theType}}:	&w{{$theType -}}{
 			{{$theType}}:	{{$theType}},
 			connDetails:	connDetails,
@@ -148,9 +151,9 @@ func wrapStmt(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor
 
 // A map of all possible driver.Conn types. The key represents which interfaces are "turned on". eg: 0b1001.
 //
-// In the example above, the following constructor is returned: get_conn_Queryer_NamedValueChecker
+// In the example above, the following constructor is returned: get_conn_QueryerContext_ConnBeginTx
 //
-// Each bit sequentially represents the interfaces: Execer, ExecerContext, Queryer, QueryerContext, ConnPrepareContext, NamedValueChecker
+// Each bit sequentially represents the interfaces: Execer, ExecerContext, Queryer, QueryerContext, ConnPrepareContext, NamedValueChecker, ConnBeginTx
 var _conn_n = map[int]func(DbConnDetails, driver.Conn, TracerLogger, {{join connInterfaces ", "}}) driver.Conn {
 	{{range $k, $v := connMap -}}
 	{{$k}}: {{$v}},
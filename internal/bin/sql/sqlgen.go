@@ -29,6 +29,7 @@ var arrayConn = []string{
 	"driver.QueryerContext",
 	"driver.ConnPrepareContext",
 	"driver.NamedValueChecker",
+	"driver.ConnBeginTx",
 }
 
 var arrayStmt = []string{
@@ -198,7 +199,7 @@ func getFilteredSubsets(basicTypeForWrapper string, listOfTheOriginalTypes []str
 		}
 	}
 
-	sort.Slice(filteredSubsets, func(i, j int) bool {
+	sort.SliceStable(filteredSubsets, func(i, j int) bool {
 		return len(filteredSubsets[i]) > len(filteredSubsets[j])
 	})
 
@@ -50,6 +50,10 @@ type Span struct {
 	ParentID        int64
 	SpanID          int64
 	Ancestor        *TraceReference
+	Links           []TraceReference
+	Stack           []StackFrame
+	Errors          []ErrorEvent
+	FollowsFrom     bool
 	Timestamp       uint64
 	Duration        uint64
 	Name            string
@@ -80,6 +84,9 @@ func newSpan(span *spanS) Span {
 		ForeignTrace:    span.context.ForeignTrace,
 		Kind:            int(data.Kind()),
 		Data:            data,
+		Stack:           span.Stack,
+		Errors:          span.Errors,
+		FollowsFrom:     span.FollowsFrom,
 	}
 
 	if bs, ok := span.Tags[batchSizeTag].(int); ok {
@@ -102,6 +109,13 @@ func newSpan(span *spanS) Span {
 		}
 	}
 
+	for _, link := range span.Links {
+		sp.Links = append(sp.Links, TraceReference{
+			TraceID:  link.TraceID,
+			ParentID: link.SpanID,
+		})
+	}
+
 	return sp
 }
 
@@ -126,21 +140,25 @@ func (sp Span) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		TraceReference
 
-		SpanID          string          `json:"s"`
-		LongTraceID     string          `json:"lt,omitempty"`
-		Timestamp       uint64          `json:"ts"`
-		Duration        uint64          `json:"d"`
-		Name            string          `json:"n"`
-		From            *fromS          `json:"f"`
-		Batch           *batchInfo      `json:"b,omitempty"`
-		Kind            int             `json:"k"`
-		Ec              int             `json:"ec,omitempty"`
-		Data            typedSpanData   `json:"data"`
-		Synthetic       bool            `json:"sy,omitempty"`
-		CorrelationType string          `json:"crtp,omitempty"`
-		CorrelationID   string          `json:"crid,omitempty"`
-		ForeignTrace    bool            `json:"tp,omitempty"`
-		Ancestor        *TraceReference `json:"ia,omitempty"`
+		SpanID          string           `json:"s"`
+		LongTraceID     string           `json:"lt,omitempty"`
+		Timestamp       uint64           `json:"ts"`
+		Duration        uint64           `json:"d"`
+		Name            string           `json:"n"`
+		From            *fromS           `json:"f"`
+		Batch           *batchInfo       `json:"b,omitempty"`
+		Kind            int              `json:"k"`
+		Ec              int              `json:"ec,omitempty"`
+		Data            typedSpanData    `json:"data"`
+		Synthetic       bool             `json:"sy,omitempty"`
+		CorrelationType string           `json:"crtp,omitempty"`
+		CorrelationID   string           `json:"crid,omitempty"`
+		ForeignTrace    bool             `json:"tp,omitempty"`
+		Ancestor        *TraceReference  `json:"ia,omitempty"`
+		Links           []TraceReference `json:"lk,omitempty"`
+		Stack           []StackFrame     `json:"stack,omitempty"`
+		Errors          []ErrorEvent     `json:"errors,omitempty"`
+		FollowsFrom     bool             `json:"ff,omitempty"`
 	}{
 		TraceReference{
 			FormatID(sp.TraceID),
@@ -161,6 +179,10 @@ func (sp Span) MarshalJSON() ([]byte, error) {
 		sp.CorrelationID,
 		sp.ForeignTrace,
 		sp.Ancestor,
+		sp.Links,
+		sp.Stack,
+		sp.Errors,
+		sp.FollowsFrom,
 	})
 }
 
@@ -220,6 +242,10 @@ func (d SpanData) Type() RegisteredSpanType {
 	return d.st
 }
 
+func (d SpanData) customSpanData() *CustomSpanData {
+	return d.Custom
+}
+
 // SDKSpanData represents the `data` section of an SDK span sent within an OT span document
 type SDKSpanData struct {
 	// Deprecated
@@ -399,6 +425,20 @@ func readBoolTag(dst *bool, tag interface{}) {
 	*dst = false
 }
 
+// readFloatTag populates the &dst with the tag value if it's of any kind of floating point or integer type
+func readFloatTag(dst *float64, tag interface{}) {
+	switch n := tag.(type) {
+	case float64:
+		*dst = n
+	case float32:
+		*dst = float64(n)
+	default:
+		var i int
+		readIntTag(&i, tag)
+		*dst = float64(i)
+	}
+}
+
 // readIntTag populates the &dst with the tag value if it's of any kind of integer type
 func readIntTag(dst *int, tag interface{}) {
 	switch n := tag.(type) {
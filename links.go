@@ -0,0 +1,87 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// SpanLinks is a list of references to spans that are causally related to a span without being its parent.
+// It can be used either as an opentracing.StartSpanOption, or to add links to an already started span with
+// (instana.SpanLinks).Set().
+type SpanLinks []SpanReference
+
+// WithLinks returns span links to the spans with provided contexts to be used as an opentracing.StartSpanOption.
+// Unlike opentracing.ChildOf() and opentracing.FollowsFrom(), links do not make the span a part of the linked
+// trace, which makes them suitable to express causal relationships between a span and multiple other spans, i.e.
+// when a single span processes a batch of messages sent by different producers. Span contexts that do not belong
+// to an Instana trace are ignored. Multiple WithLinks() options can be used for the same span.
+func WithLinks(refs ...ot.SpanContext) SpanLinks {
+	links := make(SpanLinks, 0, len(refs))
+	for _, ref := range refs {
+		sc, ok := ref.(SpanContext)
+		if !ok || (sc.TraceIDHi == 0 && sc.TraceID == 0) || sc.SpanID == 0 {
+			continue
+		}
+
+		links = append(links, newSpanReference(sc))
+	}
+
+	return links
+}
+
+// Apply implements opentracing.StartSpanOption for SpanLinks
+func (l SpanLinks) Apply(opts *ot.StartSpanOptions) {
+	if len(l) == 0 {
+		return
+	}
+
+	if opts.Tags == nil {
+		opts.Tags = make(ot.Tags)
+	}
+
+	links, _ := opts.Tags[linksTag].([]SpanReference)
+	opts.Tags[linksTag] = append(links[:len(links):len(links)], l...)
+}
+
+// Set adds links to an already started span
+func (l SpanLinks) Set(sp ot.Span) {
+	if len(l) == 0 {
+		return
+	}
+
+	sp.SetTag(linksTag, []SpanReference(l))
+}
+
+func newSpanReference(sc SpanContext) SpanReference {
+	traceID := FormatID(sc.TraceID)
+	if sc.TraceIDHi != 0 {
+		traceID = FormatLongID(sc.TraceIDHi, sc.TraceID)
+	}
+
+	return SpanReference{
+		TraceID: traceID,
+		SpanID:  FormatID(sc.SpanID),
+	}
+}
+
+// uniqueSpanReferences returns the list of span references without duplicates preserving the order
+func uniqueSpanReferences(refs []SpanReference) []SpanReference {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	seen := make(map[SpanReference]struct{}, len(refs))
+	unique := make([]SpanReference, 0, len(refs))
+
+	for _, ref := range refs {
+		if _, ok := seen[ref]; ok {
+			continue
+		}
+
+		seen[ref] = struct{}{}
+		unique = append(unique, ref)
+	}
+
+	return unique
+}
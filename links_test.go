@@ -0,0 +1,140 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLinks(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	producer1 := instana.SpanContext{TraceIDHi: 0x1, TraceID: 0x2435, SpanID: 0x3546}
+	producer2 := instana.SpanContext{TraceID: 0x5768, SpanID: 0x6879}
+
+	sp := c.Tracer().StartSpan("consumer",
+		ext.SpanKindConsumer,
+		instana.WithLinks(producer1, producer2),
+		instana.WithLinks(
+			producer1,
+			instana.SpanContext{},
+			mocktracer.New().StartSpan("other").Context(),
+		),
+	)
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, []instana.TraceReference{
+		{TraceID: "00000000000000010000000000002435", ParentID: "0000000000003546"},
+		{TraceID: "0000000000005768", ParentID: "0000000000006879"},
+	}, spans[0].Links)
+	assert.Nil(t, spans[0].Ancestor)
+
+	data, err := json.Marshal(spans[0])
+	require.NoError(t, err)
+
+	var doc struct {
+		Links []map[string]string `json:"lk"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	assert.Equal(t, []map[string]string{
+		{"t": "00000000000000010000000000002435", "p": "0000000000003546"},
+		{"t": "0000000000005768", "p": "0000000000006879"},
+	}, doc.Links)
+	assert.NotContains(t, string(data), "span_links")
+}
+
+func TestTracer_StartSpan_AdditionalReferencesAsLinks(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	parent := instana.SpanContext{TraceID: 0x1234, SpanID: 0x2345}
+	producer1 := instana.SpanContext{TraceID: 0x5768, SpanID: 0x6879}
+	producer2 := instana.SpanContext{TraceIDHi: 0x1, TraceID: 0x2435, SpanID: 0x3546}
+
+	sp := c.Tracer().StartSpan("consumer",
+		ext.SpanKindConsumer,
+		ot.ChildOf(parent),
+		ot.FollowsFrom(producer1),
+		ot.FollowsFrom(producer2),
+		instana.WithLinks(producer1),
+	)
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, parent.TraceID, spans[0].TraceID)
+	assert.Equal(t, parent.SpanID, spans[0].ParentID)
+	assert.Equal(t, []instana.TraceReference{
+		{TraceID: "0000000000005768", ParentID: "0000000000006879"},
+		{TraceID: "00000000000000010000000000002435", ParentID: "0000000000003546"},
+	}, spans[0].Links)
+}
+
+func TestSpanLinks_Set(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	producer1 := instana.SpanContext{TraceID: 0x2435, SpanID: 0x3546}
+	producer2 := instana.SpanContext{TraceID: 0x5768, SpanID: 0x6879}
+
+	sp := c.Tracer().StartSpan("consumer", ext.SpanKindConsumer, instana.WithLinks(producer1))
+	instana.WithLinks(producer1, producer2).Set(sp)
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, []instana.TraceReference{
+		{TraceID: "0000000000002435", ParentID: "0000000000003546"},
+		{TraceID: "0000000000005768", ParentID: "0000000000006879"},
+	}, spans[0].Links)
+}
+
+func TestWithLinks_NoLinks(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	sp := c.Tracer().StartSpan("consumer", ext.SpanKindConsumer, instana.WithLinks())
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Empty(t, spans[0].Links)
+
+	data, err := json.Marshal(spans[0])
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"lk"`)
+}
+
+var _ ot.StartSpanOption = instana.SpanLinks{}
@@ -34,6 +34,7 @@ type meterS struct {
 	once     sync.Once
 	stopOnce sync.Once
 	done     chan struct{}
+	interval chan time.Duration
 }
 
 // MetricsOptions contains configuration for metrics collection and transmission.
@@ -54,7 +55,7 @@ func (m *MetricsOptions) getTransmissionInterval() time.Duration {
 
 // setTransmissionInterval sets the metrics transmission interval.
 // This is an internal method called when agent configuration is received during
-// the initial handshake. The only local constraint enforced here is that the value
+// the handshake or a periodic configuration refresh. The only local constraint enforced here is that the value
 // must be positive (> 0); range and canonical-set validation is the responsibility
 // of the Instana Agent. Non-positive values fall back to defaultTransmissionInterval.
 func (m *MetricsOptions) setTransmissionInterval(seconds int) {
@@ -78,16 +79,15 @@ func newMeter(logger LeveledLogger) *meterS {
 	logger.Debug("initializing meter")
 
 	return &meterS{
-		done: make(chan struct{}),
+		done:     make(chan struct{}),
+		interval: make(chan time.Duration, 1),
 	}
 }
 
 // Run starts the metrics collection loop at the given interval.
 // It is safe to call Run multiple times — only the first call starts the loop;
 // subsequent calls (e.g. on agent reconnect) are ignored so the running loop
-// continues uninterrupted with the original interval.
-// The interval is fixed at the first call; changing poll_rate in the agent
-// configuration after startup requires an application restart to take effect.
+// continues uninterrupted. Use SetInterval() to change the interval of a running loop.
 func (m *meterS) Run(collectInterval time.Duration) {
 	if m == nil {
 		return
@@ -100,6 +100,8 @@ func (m *meterS) Run(collectInterval time.Duration) {
 				select {
 				case <-m.done:
 					return
+				case d := <-m.interval:
+					ticker.Reset(d)
 				case <-ticker.C:
 					if isAgentReady() {
 						go func() {
@@ -118,6 +120,27 @@ func (m *meterS) Run(collectInterval time.Duration) {
 	})
 }
 
+// SetInterval changes the interval of the metrics collection loop. If the loop is not running yet,
+// the new interval is applied as soon as it starts.
+func (m *meterS) SetInterval(d time.Duration) {
+	if m == nil || d <= 0 {
+		return
+	}
+
+	// replace the pending interval update, if any, with the latest one
+	for {
+		select {
+		case m.interval <- d:
+			return
+		default:
+			select {
+			case <-m.interval:
+			default:
+			}
+		}
+	}
+}
+
 // Stop shuts down the metrics collection loop. Safe to call multiple times.
 func (m *meterS) Stop() {
 	if m == nil {
@@ -159,5 +182,7 @@ func (m *meterS) collectMetrics() acceptor.Metrics {
 		CgoCall:     runtime.NumCgoCall(),
 		Goroutine:   runtime.NumGoroutine(),
 		MemoryStats: m.collectMemoryMetrics(),
+		Recorder:    RecorderStats().toAcceptorMetrics(),
+		Pools:       connectionPools.collect(),
 	}
 }
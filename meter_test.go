@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestNewMeter verifies the meter is properly initialised.
@@ -153,6 +154,23 @@ func TestMetricsOptions_SetTransmissionInterval(t *testing.T) {
 	}
 }
 
+// TestMeterSetInterval verifies that only the latest interval update is kept until the loop picks it up.
+func TestMeterSetInterval(t *testing.T) {
+	m := newMeter(defaultLogger)
+
+	m.SetInterval(5 * time.Second)
+	m.SetInterval(10 * time.Second)
+	m.SetInterval(0)
+
+	require.Len(t, m.interval, 1)
+	assert.Equal(t, 10*time.Second, <-m.interval)
+
+	assert.NotPanics(t, func() {
+		var nilMeter *meterS
+		nilMeter.SetInterval(time.Second)
+	})
+}
+
 // TestMeterCollectMetrics verifies that metric collection returns non-zero values.
 func TestMeterCollectMetrics(t *testing.T) {
 	m := newMeter(defaultLogger)
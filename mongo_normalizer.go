@@ -0,0 +1,209 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+// NormalizeMongoCommand returns the Mongo command JSON document with string and numeric values replaced
+// with "?". Arrays that only contain such values are collapsed to a single ["?"]. The field names,
+// operators, booleans, nulls and field path references, i.e. strings starting with "$", are kept intact.
+// The names of the collection and the database a command targets, such as the values of the top-level
+// "find", "aggregate", "collection" and "$db" fields, are kept as well.
+//
+// If cmd is not a valid JSON document, it is returned as is.
+func NormalizeMongoCommand(cmd string) string {
+	return normalizeMongoDocument(cmd, true)
+}
+
+// normalizeMongoDocument normalizes a Mongo JSON document. The top-level collection and database names are
+// only kept if keepNamespace is true, i.e. for complete commands, but not for the query, filter or update
+// documents sent as a part of a command, where these keys are regular field names.
+func normalizeMongoDocument(cmd string, keepNamespace bool) string {
+	dec := json.NewDecoder(strings.NewReader(cmd))
+	dec.UseNumber()
+
+	v, err := readMongoValue(dec)
+	if err != nil {
+		return cmd
+	}
+
+	// make sure there is nothing left after the document
+	if _, err := dec.Token(); err != io.EOF {
+		return cmd
+	}
+
+	if doc, ok := v.(mongoDocument); ok && keepNamespace {
+		keepMongoNamespace(doc)
+	}
+
+	var buf bytes.Buffer
+	writeNormalizedMongoValue(&buf, v)
+
+	return buf.String()
+}
+
+// mongoField is a key-value pair of a Mongo document. The fields are kept as a slice to preserve their order.
+type mongoField struct {
+	Key   string
+	Value interface{}
+}
+
+type mongoDocument []mongoField
+
+type mongoArray []interface{}
+
+// mongoName is a collection or a database name that is kept by the normalizer
+type mongoName string
+
+// mongoNamespaceKeys is the set of top-level command fields which string values are collection
+// or database names
+var mongoNamespaceKeys = map[string]struct{}{
+	"$db":           {},
+	"collection":    {},
+	"find":          {},
+	"insert":        {},
+	"update":        {},
+	"delete":        {},
+	"aggregate":     {},
+	"count":         {},
+	"distinct":      {},
+	"findAndModify": {},
+	"findandmodify": {},
+	"mapReduce":     {},
+	"create":        {},
+	"drop":          {},
+	"createIndexes": {},
+	"dropIndexes":   {},
+	"listIndexes":   {},
+	"collMod":       {},
+}
+
+// keepMongoNamespace marks the string values of the command fields that name the target collection
+// or database, so that they are not replaced by the normalizer
+func keepMongoNamespace(doc mongoDocument) {
+	for i, f := range doc {
+		if _, ok := mongoNamespaceKeys[f.Key]; !ok {
+			continue
+		}
+
+		if name, ok := f.Value.(string); ok {
+			doc[i].Value = mongoName(name)
+		}
+	}
+}
+
+func readMongoValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		var doc mongoDocument
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := readMongoValue(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			doc = append(doc, mongoField{Key: key.(string), Value: v})
+		}
+
+		// consume the closing brace
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+
+		return doc, nil
+	case json.Delim('['):
+		arr := mongoArray{}
+		for dec.More() {
+			v, err := readMongoValue(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			arr = append(arr, v)
+		}
+
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+
+		return arr, nil
+	default:
+		return tok, nil
+	}
+}
+
+// isMongoLiteral reports whether the value is replaced by the normalizer
+func isMongoLiteral(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return !strings.HasPrefix(v, "$")
+	case json.Number:
+		return true
+	default:
+		return false
+	}
+}
+
+func writeNormalizedMongoValue(buf *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case mongoDocument:
+		buf.WriteByte('{')
+		for i, f := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			key, _ := json.Marshal(f.Key)
+			buf.Write(key)
+			buf.WriteByte(':')
+			writeNormalizedMongoValue(buf, f.Value)
+		}
+		buf.WriteByte('}')
+	case mongoArray:
+		collapse := len(v) > 0
+		for _, item := range v {
+			if !isMongoLiteral(item) {
+				collapse = false
+				break
+			}
+		}
+
+		if collapse {
+			buf.WriteString(`["?"]`)
+			return
+		}
+
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			writeNormalizedMongoValue(buf, item)
+		}
+		buf.WriteByte(']')
+	default:
+		if isMongoLiteral(v) {
+			buf.WriteString(`"?"`)
+			return
+		}
+
+		data, _ := json.Marshal(v)
+		buf.Write(data)
+	}
+}
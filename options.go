@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Options allows the user to configure the to-be-initialized sensor
@@ -51,7 +52,41 @@ type Options struct {
 	// Recorder records and manages spans. When this option is not set, instana.NewRecorder() will be used.
 	Recorder SpanRecorder
 
+	// SpanProcessors is the list of hooks called whenever a span is started and finished. They are called in the order
+	// they are listed, and allow to modify or drop the span documents before they are passed to the Recorder.
+	SpanProcessors []SpanProcessor
+
+	// Spool configures the disk-backed spool for spans, profiles and events that could not be sent to the
+	// host agent. The spooled data is sent once the agent becomes available again, including after
+	// the process restart. The spool is disabled by default.
+	//
+	// Note: This setting has no effect in serverless environments and with a custom AgentClient.
+	Spool SpoolOptions
+
+	// ConfigRefreshInterval is the period of re-fetching the host agent configuration and checking the configuration
+	// file provided via INSTANA_CONFIG_PATH for changes. The changes of secrets matcher, extra HTTP headers, disabled
+	// span categories, sampler and metrics transmission interval are applied without restarting the process.
+	// The runtime reconfiguration is disabled by default, in which case the configuration is only applied once
+	// at startup.
+	//
+	// Note: the host agent configuration is re-fetched by announcing the process to the agent again.
+	//
+	// This value can also be set via the INSTANA_CONFIG_REFRESH_INTERVAL env var as a number of seconds,
+	// where 0 disables the runtime reconfiguration.
+	ConfigRefreshInterval time.Duration
+
+	// OnConfigChange, if set, is called each time the tracer configuration has been changed by the host agent
+	// configuration or the INSTANA_CONFIG_PATH file. This hook is intended to be used in tests and for diagnostics.
+	OnConfigChange func(ConfigChange)
+
 	disableW3CTraceCorrelation bool
+
+	// inCodeDisableSpans is the set of span categories disabled in code or via INSTANA_TRACING_DISABLE, that
+	// is merged with the categories from the configuration file whenever it is reloaded
+	inCodeDisableSpans map[string]bool
+	// inCodeSampler is the sampler configured in code, that is used whenever the sampler is removed from the
+	// configuration file. A nil value stands for the default sampling behavior.
+	inCodeSampler Sampler
 }
 
 // DefaultOptions returns the default set of options to configure Instana sensor.
@@ -72,6 +107,8 @@ func (opts *Options) applyConfiguration() {
 	opts.applyAgentConfiguration()
 	opts.applyServiceConfiguration()
 	opts.applyProfilingConfiguration()
+	opts.applySpoolConfiguration()
+	opts.applyConfigRefreshConfiguration()
 	opts.applyTracerConfiguration()
 }
 
@@ -125,6 +162,27 @@ func (opts *Options) applyProfilingConfiguration() {
 	}
 }
 
+// applySpoolConfiguration resolves the spool settings
+// Precedence: ENV > in-code > default
+func (opts *Options) applySpoolConfiguration() {
+	if dir, ok := os.LookupEnv("INSTANA_SPOOL_DIR"); ok && strings.TrimSpace(dir) != "" {
+		opts.Spool.Dir = strings.TrimSpace(dir)
+	}
+}
+
+// applyConfigRefreshConfiguration resolves the runtime reconfiguration settings
+// Precedence: ENV > in-code
+func (opts *Options) applyConfigRefreshConfiguration() {
+	if s, ok := os.LookupEnv("INSTANA_CONFIG_REFRESH_INTERVAL"); ok {
+		d, err := parseInstanaConfigRefreshInterval(s)
+		if err != nil {
+			defaultLogger.Warn("invalid INSTANA_CONFIG_REFRESH_INTERVAL= env variable value: ", err, ", ignoring")
+		} else {
+			opts.ConfigRefreshInterval = d
+		}
+	}
+}
+
 // applyTracerConfiguration resolves tracer-specific settings
 // Precedence: ENV > in-code > agent config > default
 func (opts *Options) applyTracerConfiguration() {
@@ -132,7 +190,45 @@ func (opts *Options) applyTracerConfiguration() {
 	opts.applyTracerDefaults()
 	opts.applyHTTPHeadersConfiguration()
 	opts.applyTracingDisableConfiguration()
+	opts.applySamplerConfiguration()
 	opts.applyW3CConfiguration()
+	opts.applyStatementNormalizationConfiguration()
+	opts.applyPropagatorsConfiguration()
+}
+
+// applyPropagatorsConfiguration resolves the list of additional trace context propagators
+// Precedence: ENV > in-code > default
+func (opts *Options) applyPropagatorsConfiguration() {
+	s, ok := os.LookupEnv("INSTANA_PROPAGATORS")
+	if !ok {
+		return
+	}
+
+	ps, err := parseInstanaPropagators(s)
+	if err != nil {
+		defaultLogger.Warn("invalid INSTANA_PROPAGATORS= env variable value: ", err, ", ignoring")
+		return
+	}
+
+	opts.Tracer.Propagators = ps
+}
+
+// applyStatementNormalizationConfiguration resolves the list of span types to normalize database statements for
+// Precedence: ENV > in-code > default
+func (opts *Options) applyStatementNormalizationConfiguration() {
+	s, ok := os.LookupEnv("INSTANA_NORMALIZE_STATEMENTS")
+	if !ok {
+		return
+	}
+
+	var spanTypes []string
+	for _, st := range strings.Split(s, ",") {
+		if st = strings.TrimSpace(st); st != "" {
+			spanTypes = append(spanTypes, st)
+		}
+	}
+
+	opts.Tracer.NormalizeStatements = spanTypes
 }
 
 // applySecretsConfiguration resolves secrets matcher configuration
@@ -151,6 +247,16 @@ func (opts *Options) applySecretsConfiguration() {
 	}
 
 	opts.Tracer.Secrets = secretsMatcher
+
+	if s, ok := os.LookupEnv("INSTANA_SECRET_VALUES"); ok {
+		red, err := parseInstanaSecretValues(s)
+		if err != nil {
+			defaultLogger.Warn("invalid INSTANA_SECRET_VALUES= env variable value: ", err, ", ignoring")
+			return
+		}
+
+		opts.Tracer.ValueRedactor = red
+	}
 }
 
 // applyTracerDefaults sets default values for tracer options
@@ -172,6 +278,12 @@ func (opts *Options) applyHTTPHeadersConfiguration() {
 // Precedence: INSTANA_CONFIG_PATH > INSTANA_TRACING_DISABLE > agent config
 func (opts *Options) applyTracingDisableConfiguration() {
 	if configPath, ok := lookupValidatedEnv("INSTANA_CONFIG_PATH"); ok {
+		opts.inCodeDisableSpans = make(map[string]bool, len(opts.Tracer.DisableSpans))
+		for k, v := range opts.Tracer.DisableSpans {
+			opts.inCodeDisableSpans[k] = v
+		}
+		opts.inCodeSampler = opts.Tracer.Sampler
+
 		if err := parseConfigFile(configPath, &opts.Tracer); err != nil {
 			defaultLogger.Warn("invalid INSTANA_CONFIG_PATH= env variable value: ", err, ", ignoring")
 		}
@@ -180,6 +292,23 @@ func (opts *Options) applyTracingDisableConfiguration() {
 	}
 }
 
+// applySamplerConfiguration resolves head-based sampling settings
+// Precedence: INSTANA_SAMPLER > INSTANA_CONFIG_PATH > in-code > default
+func (opts *Options) applySamplerConfiguration() {
+	samplerConfig, ok := lookupValidatedEnv("INSTANA_SAMPLER")
+	if !ok {
+		return
+	}
+
+	sampler, err := parseInstanaSampler(samplerConfig)
+	if err != nil {
+		defaultLogger.Warn("invalid INSTANA_SAMPLER= env variable value: ", err, ", ignoring")
+		return
+	}
+
+	opts.Tracer.Sampler = sampler
+}
+
 // applyW3CConfiguration resolves W3C trace correlation settings
 // Precedence: ENV only
 func (opts *Options) applyW3CConfiguration() {
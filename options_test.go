@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -732,6 +733,177 @@ func TestApplyHTTPHeadersConfiguration(t *testing.T) {
 	}
 }
 
+// TestApplySamplerConfiguration tests sampler configuration precedence
+func TestApplySamplerConfiguration(t *testing.T) {
+	tests := []struct {
+		name           string
+		inCodeSampler  Sampler
+		envSampler     string
+		expectedSample bool
+	}{
+		{
+			name:           "In-code only",
+			inCodeSampler:  NewNeverSampler(),
+			expectedSample: false,
+		},
+		{
+			name:           "ENV overrides in-code",
+			inCodeSampler:  NewNeverSampler(),
+			envSampler:     "always",
+			expectedSample: true,
+		},
+		{
+			name:           "Invalid ENV is ignored",
+			inCodeSampler:  NewNeverSampler(),
+			envSampler:     "probabilistic:2",
+			expectedSample: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := restoreEnvVarFunc("INSTANA_SAMPLER")
+			defer restore()
+
+			if tt.envSampler != "" {
+				os.Setenv("INSTANA_SAMPLER", tt.envSampler)
+			} else {
+				os.Unsetenv("INSTANA_SAMPLER")
+			}
+
+			opts := &Options{
+				Tracer: TracerOptions{
+					Sampler: tt.inCodeSampler,
+				},
+			}
+
+			opts.applySamplerConfiguration()
+
+			assert.Equal(t, tt.expectedSample, opts.Tracer.Sampler.ShouldSample(SamplingParameters{}))
+		})
+	}
+}
+
+func TestApplyPropagatorsConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		inCode   []Propagator
+		env      string
+		setEnv   bool
+		expected []Propagator
+	}{
+		{
+			name:     "In-code only",
+			inCode:   []Propagator{b3MultiPropagator{}},
+			expected: []Propagator{b3MultiPropagator{}},
+		},
+		{
+			name:     "ENV overrides in-code",
+			inCode:   []Propagator{b3MultiPropagator{}},
+			env:      "b3,jaeger",
+			setEnv:   true,
+			expected: []Propagator{b3SinglePropagator{}, jaegerPropagator{}},
+		},
+		{
+			name:     "Invalid ENV is ignored",
+			inCode:   []Propagator{b3MultiPropagator{}},
+			env:      "b3,unknown",
+			setEnv:   true,
+			expected: []Propagator{b3MultiPropagator{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := restoreEnvVarFunc("INSTANA_PROPAGATORS")
+			defer restore()
+
+			if tt.setEnv {
+				os.Setenv("INSTANA_PROPAGATORS", tt.env)
+			} else {
+				os.Unsetenv("INSTANA_PROPAGATORS")
+			}
+
+			opts := &Options{
+				Tracer: TracerOptions{
+					Propagators: tt.inCode,
+				},
+			}
+
+			opts.applyPropagatorsConfiguration()
+
+			assert.Equal(t, tt.expected, opts.Tracer.Propagators)
+		})
+	}
+}
+
+func TestApplyConfigRefreshConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		inCode   time.Duration
+		env      string
+		setEnv   bool
+		expected time.Duration
+	}{
+		{
+			name:     "Disabled by default",
+			expected: 0,
+		},
+		{
+			name:     "In-code only",
+			inCode:   5 * time.Minute,
+			expected: 5 * time.Minute,
+		},
+		{
+			name:     "In-code disabled",
+			inCode:   -1,
+			expected: -1,
+		},
+		{
+			name:     "ENV overrides in-code",
+			inCode:   5 * time.Minute,
+			env:      "30",
+			setEnv:   true,
+			expected: 30 * time.Second,
+		},
+		{
+			name:     "ENV disables",
+			inCode:   5 * time.Minute,
+			env:      "0",
+			setEnv:   true,
+			expected: -1,
+		},
+		{
+			name:     "Invalid ENV is ignored",
+			inCode:   5 * time.Minute,
+			env:      "5m",
+			setEnv:   true,
+			expected: 5 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := restoreEnvVarFunc("INSTANA_CONFIG_REFRESH_INTERVAL")
+			defer restore()
+
+			if tt.setEnv {
+				os.Setenv("INSTANA_CONFIG_REFRESH_INTERVAL", tt.env)
+			} else {
+				os.Unsetenv("INSTANA_CONFIG_REFRESH_INTERVAL")
+			}
+
+			opts := &Options{
+				ConfigRefreshInterval: tt.inCode,
+			}
+
+			opts.applyConfigRefreshConfiguration()
+
+			assert.Equal(t, tt.expected, opts.ConfigRefreshInterval)
+		})
+	}
+}
+
 // TestApplyW3CConfiguration tests W3C trace correlation configuration
 func TestApplyW3CConfiguration(t *testing.T) {
 	tests := []struct {
@@ -896,3 +1068,97 @@ func TestApplyConfiguration_DefaultsOnly(t *testing.T) {
 	assert.Nil(t, opts.Tracer.CollectableHTTPHeaders)
 	assert.Nil(t, opts.Tracer.DisableSpans)
 }
+
+func TestApplySpoolConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		inCodeDir   string
+		envDir      string
+		expectedDir string
+	}{
+		{
+			name:        "Disabled by default",
+			expectedDir: "",
+		},
+		{
+			name:        "In-code only",
+			inCodeDir:   "/var/spool/app",
+			expectedDir: "/var/spool/app",
+		},
+		{
+			name:        "ENV overrides in-code",
+			inCodeDir:   "/var/spool/app",
+			envDir:      " /tmp/instana ",
+			expectedDir: "/tmp/instana",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := restoreEnvVarFunc("INSTANA_SPOOL_DIR")
+			defer restore()
+
+			if tt.envDir != "" {
+				os.Setenv("INSTANA_SPOOL_DIR", tt.envDir)
+			} else {
+				os.Unsetenv("INSTANA_SPOOL_DIR")
+			}
+
+			opts := &Options{
+				Spool: SpoolOptions{Dir: tt.inCodeDir},
+			}
+			opts.applySpoolConfiguration()
+
+			assert.Equal(t, tt.expectedDir, opts.Spool.Dir)
+		})
+	}
+}
+
+func TestApplyStatementNormalizationConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		inCode   []string
+		env      *string
+		expected []string
+	}{
+		{
+			name: "Disabled by default",
+		},
+		{
+			name:     "In-code only",
+			inCode:   []string{"postgres"},
+			expected: []string{"postgres"},
+		},
+		{
+			name:     "ENV overrides in-code",
+			inCode:   []string{"postgres"},
+			env:      func(s string) *string { return &s }(" mysql, mongo ,"),
+			expected: []string{"mysql", "mongo"},
+		},
+		{
+			name:   "Empty ENV disables normalization",
+			inCode: []string{"postgres"},
+			env:    func(s string) *string { return &s }(""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := restoreEnvVarFunc("INSTANA_NORMALIZE_STATEMENTS")
+			defer restore()
+
+			if tt.env != nil {
+				os.Setenv("INSTANA_NORMALIZE_STATEMENTS", *tt.env)
+			} else {
+				os.Unsetenv("INSTANA_NORMALIZE_STATEMENTS")
+			}
+
+			opts := &Options{
+				Tracer: TracerOptions{NormalizeStatements: tt.inCode},
+			}
+			opts.applyStatementNormalizationConfiguration()
+
+			assert.Equal(t, tt.expected, opts.Tracer.NormalizeStatements)
+		})
+	}
+}
@@ -0,0 +1,486 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/instana/go-sensor/acceptor"
+	"github.com/instana/go-sensor/autoprofile"
+)
+
+// OTLPEncoding is the payload encoding used by the OTLP/HTTP exporter
+type OTLPEncoding string
+
+const (
+	// OTLPProtobuf encodes export requests as binary protobuf messages (Content-Type: application/x-protobuf)
+	OTLPProtobuf OTLPEncoding = "protobuf"
+	// OTLPJSON encodes export requests using the JSON protobuf encoding (Content-Type: application/json)
+	OTLPJSON OTLPEncoding = "json"
+)
+
+// Default OTLP/HTTP exporter configuration
+const (
+	DefaultOTLPEndpoint      = "http://localhost:4318/v1/traces"
+	DefaultOTLPMaxBatchSize  = 512
+	DefaultOTLPMaxQueueSize  = 2048
+	DefaultOTLPFlushInterval = time.Second
+	DefaultOTLPMaxRetries    = 3
+	DefaultOTLPRetryBackoff  = 500 * time.Millisecond
+	DefaultOTLPTimeout       = 10 * time.Second
+)
+
+// OTLPExporterOptions contains the configuration of the OTLP/HTTP exporter
+type OTLPExporterOptions struct {
+	// Endpoint is the URL of the OTLP/HTTP traces endpoint. If empty, instana.DefaultOTLPEndpoint is used
+	Endpoint string
+	// Headers are added to each export request, i.e. to provide the authentication credentials
+	Headers map[string]string
+	// Encoding is the payload encoding, either instana.OTLPProtobuf (default) or instana.OTLPJSON
+	Encoding OTLPEncoding
+	// Gzip enables the gzip compression of export requests
+	Gzip bool
+	// ServiceName is used as the service.name resource attribute for spans that do not specify
+	// a service. If empty, the service name of the sensor is used
+	ServiceName string
+	// MaxBatchSize is the maximum number of spans sent within one export request
+	MaxBatchSize int
+	// MaxQueueSize is the maximum number of spans waiting to be exported. Once the queue is full,
+	// the oldest spans are dropped
+	MaxQueueSize int
+	// FlushInterval is how often the queued spans are exported
+	FlushInterval time.Duration
+	// MaxRetries is the number of attempts to re-send a request that failed with a retryable error.
+	// Set it to a negative value to disable retries
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry, doubled with each subsequent attempt. The
+	// value of Retry-After response header takes precedence if provided by the collector
+	RetryBackoff time.Duration
+	// Client is the HTTP client used to send export requests. If nil, a client with
+	// instana.DefaultOTLPTimeout timeout is used
+	Client *http.Client
+}
+
+func (opts *OTLPExporterOptions) setDefaults() {
+	if opts.Endpoint == "" {
+		opts.Endpoint = DefaultOTLPEndpoint
+	}
+
+	if opts.Encoding == "" {
+		opts.Encoding = OTLPProtobuf
+	}
+
+	if opts.MaxBatchSize <= 0 {
+		opts.MaxBatchSize = DefaultOTLPMaxBatchSize
+	}
+
+	if opts.MaxQueueSize <= 0 {
+		opts.MaxQueueSize = DefaultOTLPMaxQueueSize
+	}
+
+	if opts.MaxQueueSize < opts.MaxBatchSize {
+		opts.MaxQueueSize = opts.MaxBatchSize
+	}
+
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DefaultOTLPFlushInterval
+	}
+
+	switch {
+	case opts.MaxRetries == 0:
+		opts.MaxRetries = DefaultOTLPMaxRetries
+	case opts.MaxRetries < 0:
+		opts.MaxRetries = 0
+	}
+
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = DefaultOTLPRetryBackoff
+	}
+
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: DefaultOTLPTimeout}
+	}
+}
+
+// otlpRetryableError is returned for the export requests that can be retried, providing
+// an optional delay requested by the collector
+type otlpRetryableError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func (e otlpRetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e otlpRetryableError) Unwrap() error {
+	return e.err
+}
+
+// OTLPExporter is an instana.AgentClient exporting spans to an OpenTelemetry collector over OTLP/HTTP
+type OTLPExporter interface {
+	AgentClient
+	// Close stops the background export and sends the spans remaining in the queue
+	Close(context.Context) error
+}
+
+type otlpExporter struct {
+	opts OTLPExporterOptions
+
+	mu        sync.Mutex
+	spanQueue []Span
+
+	// flushMu ensures that only one export is in progress at a time
+	flushMu sync.Mutex
+	flushCh chan struct{}
+
+	// ctx is canceled once the exporter is closed to abort the background export
+	ctx       context.Context
+	cancel    context.CancelFunc
+	stopped   chan struct{}
+	closeOnce sync.Once
+
+	sleep  func(context.Context, time.Duration) error
+	logger LeveledLogger
+}
+
+// NewOTLPExporter returns an instana.AgentClient that exports spans in the OTLP format to an OpenTelemetry
+// collector over HTTP instead of sending them to the Instana agent. This allows to run the instrumented
+// application in environments where no Instana agent is available:
+//
+//	col := instana.InitCollector(&instana.Options{
+//		Service: "my-service",
+//		AgentClient: instana.NewOTLPExporter(instana.OTLPExporterOptions{
+//			Endpoint: "http://otel-collector:4318/v1/traces",
+//			Gzip:     true,
+//		}),
+//	})
+//
+// The spans are queued and exported in batches once the queue contains enough spans to fill a batch, or
+// when the flush interval passes. Requests that failed due to a network error or have been rejected by
+// the collector with 429, 502, 503 or 504 response status are retried with an exponential backoff. If
+// all attempts fail, the spans are returned to the queue to be sent with the next export.
+//
+// Flushing the tracer with Collector.Flush() exports the recorded spans before returning. Call Close()
+// on shutdown to stop the background export and send the remaining spans:
+//
+//	defer exporter.Close(ctx)
+//	defer col.Flush(ctx)
+//
+// Since OTLP export of metrics, events and profiles is not supported, this data is discarded.
+func NewOTLPExporter(opts OTLPExporterOptions) OTLPExporter {
+	e := newOTLPExporter(opts)
+	go e.run()
+
+	return e
+}
+
+func newOTLPExporter(opts OTLPExporterOptions) *otlpExporter {
+	opts.setDefaults()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &otlpExporter{
+		opts:    opts,
+		flushCh: make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+		stopped: make(chan struct{}),
+		sleep:   sleepWithContext,
+		logger:  defaultLogger,
+	}
+}
+
+func (e *otlpExporter) run() {
+	defer close(e.stopped)
+
+	t := time.NewTicker(e.opts.FlushInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-e.flushCh:
+		case <-e.ctx.Done():
+			return
+		}
+
+		if err := e.Flush(e.ctx); err != nil && e.ctx.Err() == nil {
+			e.logger.Error("failed to export spans to the OTLP endpoint: ", err)
+		}
+	}
+}
+
+// Close stops the background export and sends the spans remaining in the queue. It returns an error if
+// any of them could not be exported before the context is done.
+func (e *otlpExporter) Close(ctx context.Context) error {
+	e.closeOnce.Do(e.cancel)
+
+	select {
+	case <-e.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return e.Flush(ctx)
+}
+
+// Ready returns true, since the exporter does not need to announce itself to the agent
+func (e *otlpExporter) Ready() bool { return true }
+
+// SendMetrics discards the metrics
+func (e *otlpExporter) SendMetrics(acceptor.Metrics) error { return nil }
+
+// SendEvent discards the event
+func (e *otlpExporter) SendEvent(*EventData) error { return nil }
+
+// SendProfiles discards the profiles
+func (e *otlpExporter) SendProfiles([]autoprofile.Profile) error { return nil }
+
+// SendSpans queues spans to be exported with the next batch
+func (e *otlpExporter) SendSpans(spans []Span) error {
+	if e.enqueueSpans(spans) >= e.opts.MaxBatchSize {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Flush exports all queued spans. It returns an error if any of the batches could not be sent, in
+// which case the remaining spans stay in the queue.
+func (e *otlpExporter) Flush(ctx context.Context) error {
+	e.flushMu.Lock()
+	defer e.flushMu.Unlock()
+
+	for {
+		batch := e.dequeueBatch()
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := e.export(ctx, batch); err != nil {
+			var retryable otlpRetryableError
+			if errors.As(err, &retryable) {
+				e.requeueSpans(batch)
+			}
+
+			return err
+		}
+	}
+}
+
+// enqueueSpans appends spans to the queue dropping the oldest ones if the queue size
+// exceeds the limit. It returns the resulting queue length.
+func (e *otlpExporter) enqueueSpans(spans []Span) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spanQueue = append(e.spanQueue, spans...)
+	e.truncateQueue()
+
+	return len(e.spanQueue)
+}
+
+// requeueSpans returns spans that failed to be exported to the head of the queue
+func (e *otlpExporter) requeueSpans(spans []Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spanQueue = append(append(make([]Span, 0, len(spans)+len(e.spanQueue)), spans...), e.spanQueue...)
+	e.truncateQueue()
+}
+
+func (e *otlpExporter) truncateQueue() {
+	if dropped := len(e.spanQueue) - e.opts.MaxQueueSize; dropped > 0 {
+		e.logger.Warn("OTLP exporter queue is full, dropping ", dropped, " oldest span(s)")
+		e.spanQueue = append(e.spanQueue[:0], e.spanQueue[dropped:]...)
+	}
+}
+
+func (e *otlpExporter) dequeueBatch() []Span {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	n := len(e.spanQueue)
+	if n > e.opts.MaxBatchSize {
+		n = e.opts.MaxBatchSize
+	}
+
+	batch := make([]Span, n)
+	copy(batch, e.spanQueue)
+	e.spanQueue = append(e.spanQueue[:0], e.spanQueue[n:]...)
+
+	return batch
+}
+
+// export sends a batch of spans retrying the request in case of a retryable error
+func (e *otlpExporter) export(ctx context.Context, spans []Span) error {
+	body, err := e.encode(spans)
+	if err != nil {
+		return fmt.Errorf("failed to encode OTLP export request: %w", err)
+	}
+
+	backoff := e.opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err = e.send(ctx, body)
+		if err == nil {
+			return nil
+		}
+
+		var retryable otlpRetryableError
+		if !errors.As(err, &retryable) || attempt >= e.opts.MaxRetries {
+			return err
+		}
+
+		delay := backoff
+		if retryable.retryAfter > 0 {
+			delay = retryable.retryAfter
+		}
+
+		e.logger.Debug("failed to export spans, retrying in ", delay, ": ", err)
+		if sleepErr := e.sleep(ctx, delay); sleepErr != nil {
+			return otlpRetryableError{err: sleepErr}
+		}
+
+		backoff *= 2
+	}
+}
+
+func (e *otlpExporter) encode(spans []Span) ([]byte, error) {
+	req := newOTLPTracesRequest(spans, e.serviceName())
+
+	var (
+		payload []byte
+		err     error
+	)
+
+	switch e.opts.Encoding {
+	case OTLPJSON:
+		payload, err = json.Marshal(req)
+	case OTLPProtobuf:
+		payload = req.marshalProto()
+	default:
+		return nil, fmt.Errorf("unsupported OTLP encoding %q", e.opts.Encoding)
+	}
+
+	if err != nil || !e.opts.Gzip {
+		return payload, err
+	}
+
+	buf := bytes.NewBuffer(nil)
+	zw := gzip.NewWriter(buf)
+
+	if _, err := zw.Write(payload); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (e *otlpExporter) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to prepare OTLP export request: %w", err)
+	}
+
+	for k, v := range e.opts.Headers {
+		req.Header.Set(k, v)
+	}
+
+	switch e.opts.Encoding {
+	case OTLPJSON:
+		req.Header.Set("Content-Type", "application/json")
+	default:
+		req.Header.Set("Content-Type", "application/x-protobuf")
+	}
+
+	if e.opts.Gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	resp, err := e.opts.Client.Do(req)
+	if err != nil {
+		return otlpRetryableError{err: fmt.Errorf("failed to send OTLP export request: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+
+	if resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	err = fmt.Errorf("OTLP endpoint has responded with %s: %s", resp.Status, respBody)
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return otlpRetryableError{
+			err:        err,
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	return err
+}
+
+func (e *otlpExporter) serviceName() string {
+	if e.opts.ServiceName != "" {
+		return e.opts.ServiceName
+	}
+
+	s, err := getSensor()
+	if err != nil {
+		return binaryName
+	}
+
+	return s.serviceOrBinaryName()
+}
+
+// parseRetryAfter parses the value of Retry-After header provided either as a number
+// of seconds, or as an HTTP date
+func parseRetryAfter(s string) time.Duration {
+	if s == "" {
+		return 0
+	}
+
+	if sec, err := strconv.Atoi(s); err == nil && sec > 0 {
+		return time.Duration(sec) * time.Second
+	}
+
+	if t, err := http.ParseTime(s); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,382 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type otlpTestRequest struct {
+	Header http.Header
+	Body   []byte
+}
+
+type otlpTestServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []otlpTestRequest
+	statuses []int
+}
+
+// newOTLPTestServer starts a test server responding with provided statuses in order, and with
+// 200 OK once there are no more statuses left
+func newOTLPTestServer(t *testing.T, statuses ...int) *otlpTestServer {
+	t.Helper()
+
+	srv := &otlpTestServer{statuses: statuses}
+	srv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+
+		srv.requests = append(srv.requests, otlpTestRequest{Header: req.Header.Clone(), Body: body})
+
+		status := http.StatusOK
+		if len(srv.statuses) > 0 {
+			status, srv.statuses = srv.statuses[0], srv.statuses[1:]
+		}
+
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "3")
+		}
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func (srv *otlpTestServer) Requests() []otlpTestRequest {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	return append([]otlpTestRequest(nil), srv.requests...)
+}
+
+func newTestOTLPExporter(opts OTLPExporterOptions) (*otlpExporter, *[]time.Duration) {
+	var delays []time.Duration
+
+	e := newOTLPExporter(opts)
+	e.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	return e, &delays
+}
+
+func recordTestSpans(t *testing.T, startSpans func(tracer ot.Tracer)) []Span {
+	t.Helper()
+
+	recorder := NewTestRecorder()
+	tracer := NewTracerWithEverything(&Options{
+		Service:     "test-service",
+		AgentClient: alwaysReadyClient{},
+	}, recorder)
+	t.Cleanup(ShutdownSensor)
+
+	startSpans(tracer)
+
+	return recorder.GetQueuedSpans()
+}
+
+func TestOTLPExporter_Flush_JSON(t *testing.T) {
+	spans := recordTestSpans(t, func(tracer ot.Tracer) {
+		entry := tracer.StartSpan("g.http", ext.SpanKindRPCServer, ot.Tags{
+			"http.method": "GET",
+			"http.status": 500,
+		})
+		entry.SetTag(string(ext.Error), true)
+
+		tracer.StartSpan("my-operation", ot.ChildOf(entry.Context()), ot.Tags{
+			"key": "value",
+		}).Finish()
+
+		entry.Finish()
+	})
+	require.Len(t, spans, 2)
+
+	srv := newOTLPTestServer(t)
+	e, _ := newTestOTLPExporter(OTLPExporterOptions{
+		Endpoint: srv.URL + "/v1/traces",
+		Encoding: OTLPJSON,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+
+	require.NoError(t, e.SendSpans(spans))
+	require.NoError(t, e.Flush(context.Background()))
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 1)
+
+	assert.Equal(t, "application/json", reqs[0].Header.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", reqs[0].Header.Get("Authorization"))
+	assert.Empty(t, reqs[0].Header.Get("Content-Encoding"))
+
+	var payload otlpTracesRequest
+	require.NoError(t, json.Unmarshal(reqs[0].Body, &payload))
+
+	require.Len(t, payload.ResourceSpans, 1)
+	assert.Contains(t, payload.ResourceSpans[0].Resource.Attributes, otlpKeyValue{
+		Key:   "service.name",
+		Value: newOTLPAnyValue("test-service"),
+	})
+
+	require.Len(t, payload.ResourceSpans[0].ScopeSpans, 1)
+	otlpSpans := payload.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, otlpSpans, 2)
+
+	child, entry := otlpSpans[0], otlpSpans[1]
+
+	assert.Equal(t, FormatLongID(spans[1].TraceIDHi, spans[1].TraceID), entry.TraceID)
+	assert.Equal(t, FormatID(spans[1].SpanID), entry.SpanID)
+	assert.Empty(t, entry.ParentSpanID)
+	assert.Equal(t, "g.http", entry.Name)
+	assert.Equal(t, otlpSpanKindServer, entry.Kind)
+	assert.Equal(t, otlpStatusCodeError, entry.Status.Code)
+	assert.Equal(t, spans[1].Timestamp*uint64(time.Millisecond), entry.StartTimeUnixNano)
+	assert.Contains(t, entry.Attributes, otlpKeyValue{Key: "http.method", Value: newOTLPAnyValue("GET")})
+	assert.Contains(t, entry.Attributes, otlpKeyValue{Key: "http.status", Value: newOTLPAnyValue(500)})
+
+	assert.Equal(t, entry.TraceID, child.TraceID)
+	assert.Equal(t, entry.SpanID, child.ParentSpanID)
+	assert.Equal(t, "my-operation", child.Name)
+	assert.Equal(t, otlpSpanKindInternal, child.Kind)
+	assert.Zero(t, child.Status.Code)
+	assert.Contains(t, child.Attributes, otlpKeyValue{Key: "key", Value: newOTLPAnyValue("value")})
+}
+
+func TestOTLPExporter_Flush_ProtobufGzip(t *testing.T) {
+	spans := recordTestSpans(t, func(tracer ot.Tracer) {
+		tracer.StartSpan("my-operation", ext.SpanKindRPCServer).Finish()
+	})
+	require.Len(t, spans, 1)
+
+	srv := newOTLPTestServer(t)
+	e, _ := newTestOTLPExporter(OTLPExporterOptions{
+		Endpoint: srv.URL,
+		Gzip:     true,
+	})
+
+	require.NoError(t, e.SendSpans(spans))
+	require.NoError(t, e.Flush(context.Background()))
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 1)
+
+	assert.Equal(t, "application/x-protobuf", reqs[0].Header.Get("Content-Type"))
+	assert.Equal(t, "gzip", reqs[0].Header.Get("Content-Encoding"))
+
+	zr, err := gzip.NewReader(bytes.NewReader(reqs[0].Body))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(zr)
+	require.NoError(t, err)
+
+	// ExportTraceServiceRequest.resource_spans, field 1, length-delimited
+	require.NotEmpty(t, body)
+	assert.Equal(t, byte(0x0a), body[0])
+
+	traceID, err := hex.DecodeString(FormatLongID(spans[0].TraceIDHi, spans[0].TraceID))
+	require.NoError(t, err)
+
+	// Span.trace_id, field 1, length-delimited, 16 bytes
+	assert.Contains(t, string(body), string(append([]byte{0x0a, 0x10}, traceID...)))
+	// Span.name, field 5, length-delimited
+	assert.Contains(t, string(body), "\x2a\x0cmy-operation")
+}
+
+func TestOTLPExporter_Flush_Batches(t *testing.T) {
+	spans := recordTestSpans(t, func(tracer ot.Tracer) {
+		for i := 0; i < 5; i++ {
+			tracer.StartSpan("test").Finish()
+		}
+	})
+
+	srv := newOTLPTestServer(t)
+	e, _ := newTestOTLPExporter(OTLPExporterOptions{
+		Endpoint:     srv.URL,
+		Encoding:     OTLPJSON,
+		MaxBatchSize: 2,
+	})
+
+	require.NoError(t, e.SendSpans(spans))
+	require.NoError(t, e.Flush(context.Background()))
+
+	var sizes []int
+	for _, req := range srv.Requests() {
+		var payload otlpTracesRequest
+		require.NoError(t, json.Unmarshal(req.Body, &payload))
+
+		sizes = append(sizes, len(payload.ResourceSpans[0].ScopeSpans[0].Spans))
+	}
+
+	assert.Equal(t, []int{2, 2, 1}, sizes)
+}
+
+func TestOTLPExporter_Flush_Retry(t *testing.T) {
+	spans := recordTestSpans(t, func(tracer ot.Tracer) {
+		tracer.StartSpan("test").Finish()
+	})
+
+	srv := newOTLPTestServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	e, delays := newTestOTLPExporter(OTLPExporterOptions{
+		Endpoint:     srv.URL,
+		RetryBackoff: 100 * time.Millisecond,
+	})
+
+	require.NoError(t, e.SendSpans(spans))
+	require.NoError(t, e.Flush(context.Background()))
+
+	assert.Len(t, srv.Requests(), 3)
+	// the second delay is provided via Retry-After header
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 3 * time.Second}, *delays)
+}
+
+func TestOTLPExporter_Flush_RetriesExhausted(t *testing.T) {
+	spans := recordTestSpans(t, func(tracer ot.Tracer) {
+		tracer.StartSpan("test").Finish()
+	})
+
+	srv := newOTLPTestServer(t, http.StatusBadGateway, http.StatusBadGateway)
+	e, _ := newTestOTLPExporter(OTLPExporterOptions{
+		Endpoint:   srv.URL,
+		MaxRetries: 1,
+	})
+
+	require.NoError(t, e.SendSpans(spans))
+	assert.Error(t, e.Flush(context.Background()))
+	assert.Len(t, srv.Requests(), 2)
+
+	// the spans are sent again with the next flush
+	require.NoError(t, e.Flush(context.Background()))
+	assert.Len(t, srv.Requests(), 3)
+}
+
+func TestOTLPExporter_Flush_NonRetryableError(t *testing.T) {
+	spans := recordTestSpans(t, func(tracer ot.Tracer) {
+		sp := tracer.StartSpan("test")
+		sp.LogFields(otlog.Error(errors.New("something went wrong")))
+		sp.Finish()
+	})
+
+	srv := newOTLPTestServer(t, http.StatusBadRequest)
+	e, delays := newTestOTLPExporter(OTLPExporterOptions{
+		Endpoint: srv.URL,
+	})
+
+	require.NoError(t, e.SendSpans(spans))
+	assert.Error(t, e.Flush(context.Background()))
+	assert.Empty(t, *delays)
+
+	// the rejected spans are dropped
+	require.NoError(t, e.Flush(context.Background()))
+	assert.Len(t, srv.Requests(), 1)
+}
+
+func TestOTLPExporter_SendSpans_MaxQueueSize(t *testing.T) {
+	e, _ := newTestOTLPExporter(OTLPExporterOptions{
+		MaxBatchSize: 2,
+		MaxQueueSize: 3,
+	})
+
+	require.NoError(t, e.SendSpans([]Span{{SpanID: 1}, {SpanID: 2}}))
+	require.NoError(t, e.SendSpans([]Span{{SpanID: 3}, {SpanID: 4}}))
+
+	assert.Equal(t, []Span{{SpanID: 2}, {SpanID: 3}, {SpanID: 4}}, e.spanQueue)
+}
+
+func TestNewOTLPExporter(t *testing.T) {
+	srv := newOTLPTestServer(t)
+
+	e := NewOTLPExporter(OTLPExporterOptions{
+		Endpoint:      srv.URL,
+		FlushInterval: 10 * time.Millisecond,
+	})
+
+	assert.True(t, e.Ready())
+	require.NoError(t, e.SendSpans([]Span{{TraceID: 1, SpanID: 1, Name: "test"}}))
+
+	assert.Eventually(t, func() bool {
+		return len(srv.Requests()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5"))
+	assert.Zero(t, parseRetryAfter(""))
+	assert.Zero(t, parseRetryAfter("soon"))
+	assert.Zero(t, parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)))
+	assert.InDelta(t, time.Minute, parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)), float64(2*time.Second))
+}
+
+func TestOTLPExporter_Close(t *testing.T) {
+	srv := newOTLPTestServer(t)
+
+	e := NewOTLPExporter(OTLPExporterOptions{
+		Endpoint:      srv.URL,
+		FlushInterval: time.Hour,
+	})
+
+	require.NoError(t, e.SendSpans([]Span{{TraceID: 1, SpanID: 1, Name: "test"}}))
+	assert.Empty(t, srv.Requests())
+
+	require.NoError(t, e.Close(context.Background()))
+	assert.Len(t, srv.Requests(), 1)
+
+	select {
+	case <-e.(*otlpExporter).stopped:
+	default:
+		t.Error("the background export has not been stopped")
+	}
+
+	// closing an exporter twice is a no-op
+	require.NoError(t, e.Close(context.Background()))
+}
+
+func TestOTLPExporter_TracerFlush(t *testing.T) {
+	srv := newOTLPTestServer(t)
+
+	e := NewOTLPExporter(OTLPExporterOptions{
+		Endpoint:      srv.URL,
+		FlushInterval: time.Hour,
+	})
+	defer e.Close(context.Background())
+
+	rec := NewRecorder()
+	defer rec.Close()
+
+	c := InitCollector(&Options{
+		Service:     "test-service",
+		AgentClient: e,
+		Recorder:    rec,
+	})
+	defer ShutdownCollector()
+
+	c.Tracer().StartSpan("test").Finish()
+
+	// the spans are exported before the flush returns
+	require.NoError(t, c.Tracer().(Tracer).Flush(context.Background()))
+	assert.Len(t, srv.Requests(), 1)
+}
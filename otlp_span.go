@@ -0,0 +1,536 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// OTLP span kinds, see https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+	otlpSpanKindProducer = 4
+	otlpSpanKindConsumer = 5
+)
+
+const (
+	otlpStatusCodeError = 2
+	otlpScopeName       = "github.com/instana/go-sensor"
+)
+
+// otlpTracesRequest represents the ExportTraceServiceRequest OTLP message
+type otlpTracesRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano uint64         `json:"startTimeUnixNano,string"`
+	EndTimeUnixNano   uint64         `json:"endTimeUnixNano,string"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Links             []otlpLink     `json:"links,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpLink struct {
+	TraceID string `json:"traceId"`
+	SpanID  string `json:"spanId"`
+}
+
+type otlpStatus struct {
+	Code int `json:"code,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+// otlpAnyValue is the value of an OTLP attribute. Only one of the fields is set.
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *int64          `json:"intValue,omitempty,string"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+// newOTLPTracesRequest converts Instana spans into an OTLP export request. The spans are grouped
+// into resources by their service name, falling back to defaultService if the span does not have one.
+func newOTLPTracesRequest(spans []Span, defaultService string) otlpTracesRequest {
+	var (
+		req      otlpTracesRequest
+		services = make(map[string]int)
+	)
+
+	for _, sp := range spans {
+		service, span := newOTLPSpan(sp)
+		if service == "" {
+			service = defaultService
+		}
+
+		ind, ok := services[service]
+		if !ok {
+			ind = len(req.ResourceSpans)
+			services[service] = ind
+
+			req.ResourceSpans = append(req.ResourceSpans, otlpResourceSpans{
+				Resource: newOTLPResource(service),
+				ScopeSpans: []otlpScopeSpans{{
+					Scope: otlpScope{Name: otlpScopeName, Version: Version},
+				}},
+			})
+		}
+
+		req.ResourceSpans[ind].ScopeSpans[0].Spans = append(req.ResourceSpans[ind].ScopeSpans[0].Spans, span)
+	}
+
+	return req
+}
+
+func newOTLPResource(service string) otlpResource {
+	attrs := []otlpKeyValue{
+		{Key: "service.name", Value: newOTLPAnyValue(service)},
+		{Key: "process.pid", Value: newOTLPAnyValue(os.Getpid())},
+		{Key: "telemetry.sdk.name", Value: newOTLPAnyValue("instana")},
+		{Key: "telemetry.sdk.language", Value: newOTLPAnyValue("go")},
+		{Key: "telemetry.sdk.version", Value: newOTLPAnyValue(Version)},
+	}
+
+	if hostname, err := os.Hostname(); err == nil {
+		attrs = append(attrs, otlpKeyValue{Key: "host.name", Value: newOTLPAnyValue(hostname)})
+	}
+
+	return otlpResource{Attributes: attrs}
+}
+
+// newOTLPSpan converts an Instana span into its OTLP representation. The span data is
+// flattened into attributes using the dot-separated path of each field, i.e. data.http.method
+// becomes the http.method attribute. The tags of an SDK span are used as attributes as is.
+func newOTLPSpan(sp Span) (string, otlpSpan) {
+	span := otlpSpan{
+		TraceID:           FormatLongID(sp.TraceIDHi, sp.TraceID),
+		SpanID:            FormatID(sp.SpanID),
+		Name:              sp.Name,
+		Kind:              otlpSpanKind(sp),
+		StartTimeUnixNano: sp.Timestamp * uint64(time.Millisecond),
+		EndTimeUnixNano:   (sp.Timestamp + sp.Duration) * uint64(time.Millisecond),
+	}
+
+	if sp.ParentID != 0 {
+		span.ParentSpanID = FormatID(sp.ParentID)
+	}
+
+	if sp.Ec > 0 {
+		span.Status.Code = otlpStatusCodeError
+	}
+
+	if sp.Ancestor != nil && sp.Ancestor.ParentID != "" {
+		span.Links = append(span.Links, otlpLink{
+			TraceID: padOTLPID(sp.Ancestor.TraceID, 32),
+			SpanID:  padOTLPID(sp.Ancestor.ParentID, 16),
+		})
+	}
+
+	for _, link := range sp.Links {
+		span.Links = append(span.Links, otlpLink{
+			TraceID: padOTLPID(link.TraceID, 32),
+			SpanID:  padOTLPID(link.ParentID, 16),
+		})
+	}
+
+	attrs := map[string]interface{}{
+		"instana.span.type": sp.Name,
+	}
+
+	if sp.Ec > 0 {
+		attrs["instana.error_count"] = sp.Ec
+	}
+
+	// follows-from references are reported the same way as by the OpenTelemetry OpenTracing shim
+	if sp.FollowsFrom {
+		attrs["opentracing.ref_type"] = "follows_from"
+	}
+
+	var service string
+	switch data := sp.Data.(type) {
+	case SDKSpanData:
+		service = data.Service
+		span.Name = data.Tags.Name
+
+		if tags, ok := data.Tags.Custom["tags"].(ot.Tags); ok {
+			for k, v := range tags {
+				attrs[k] = v
+			}
+		}
+	case nil:
+	default:
+		var fields map[string]interface{}
+		if err := unmarshalOTLPData(data, &fields); err == nil {
+			service, _ = fields["service"].(string)
+			delete(fields, "service")
+
+			flattenOTLPAttributes(attrs, "", fields)
+		}
+	}
+
+	span.Attributes = newOTLPAttributes(attrs)
+
+	return service, span
+}
+
+func otlpSpanKind(sp Span) int {
+	messaging := false
+	switch RegisteredSpanType(sp.Name) {
+	case KafkaSpanType, RabbitMQSpanType, GCPPubSubSpanType, AWSSQSSpanType, AWSSNSSpanType:
+		messaging = true
+	}
+
+	switch SpanKind(sp.Kind) {
+	case EntrySpanKind:
+		if messaging {
+			return otlpSpanKindConsumer
+		}
+
+		return otlpSpanKindServer
+	case ExitSpanKind:
+		if messaging {
+			return otlpSpanKindProducer
+		}
+
+		return otlpSpanKindClient
+	default:
+		return otlpSpanKindInternal
+	}
+}
+
+// unmarshalOTLPData marshals typed span data to JSON and decodes it back into dst, preserving
+// the integer values
+func unmarshalOTLPData(data typedSpanData, dst interface{}) error {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+
+	return dec.Decode(dst)
+}
+
+func flattenOTLPAttributes(dst map[string]interface{}, prefix string, fields map[string]interface{}) {
+	for k, v := range fields {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenOTLPAttributes(dst, k, nested)
+			continue
+		}
+
+		dst[k] = v
+	}
+}
+
+func newOTLPAttributes(attrs map[string]interface{}) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(attrs))
+	for k, v := range attrs {
+		kvs = append(kvs, otlpKeyValue{Key: k, Value: newOTLPAnyValue(v)})
+	}
+
+	// keep the attributes order stable
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+
+	return kvs
+}
+
+func newOTLPAnyValue(v interface{}) otlpAnyValue {
+	switch v := v.(type) {
+	case string:
+		return otlpAnyValue{StringValue: &v}
+	case []byte:
+		s := string(v)
+		return otlpAnyValue{StringValue: &s}
+	case bool:
+		return otlpAnyValue{BoolValue: &v}
+	case int:
+		return newOTLPIntValue(int64(v))
+	case int8:
+		return newOTLPIntValue(int64(v))
+	case int16:
+		return newOTLPIntValue(int64(v))
+	case int32:
+		return newOTLPIntValue(int64(v))
+	case int64:
+		return newOTLPIntValue(v)
+	case uint:
+		return newOTLPIntValue(int64(v))
+	case uint8:
+		return newOTLPIntValue(int64(v))
+	case uint16:
+		return newOTLPIntValue(int64(v))
+	case uint32:
+		return newOTLPIntValue(int64(v))
+	case uint64:
+		return newOTLPIntValue(int64(v))
+	case float32:
+		return newOTLPDoubleValue(float64(v))
+	case float64:
+		return newOTLPDoubleValue(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return newOTLPIntValue(i)
+		}
+
+		if f, err := v.Float64(); err == nil {
+			return newOTLPDoubleValue(f)
+		}
+
+		s := v.String()
+		return otlpAnyValue{StringValue: &s}
+	case []string:
+		arr := &otlpArrayValue{Values: make([]otlpAnyValue, 0, len(v))}
+		for _, el := range v {
+			arr.Values = append(arr.Values, newOTLPAnyValue(el))
+		}
+
+		return otlpAnyValue{ArrayValue: arr}
+	case []interface{}:
+		arr := &otlpArrayValue{Values: make([]otlpAnyValue, 0, len(v))}
+		for _, el := range v {
+			arr.Values = append(arr.Values, newOTLPAnyValue(el))
+		}
+
+		return otlpAnyValue{ArrayValue: arr}
+	case nil:
+		s := ""
+		return otlpAnyValue{StringValue: &s}
+	}
+
+	// fall back to the JSON representation for any other type
+	s := ""
+	if buf, err := json.Marshal(v); err == nil {
+		s = string(buf)
+	}
+
+	return otlpAnyValue{StringValue: &s}
+}
+
+func newOTLPIntValue(i int64) otlpAnyValue {
+	return otlpAnyValue{IntValue: &i}
+}
+
+func newOTLPDoubleValue(f float64) otlpAnyValue {
+	return otlpAnyValue{DoubleValue: &f}
+}
+
+// padOTLPID left-pads a hex-encoded ID with zeroes up to the length required by OTLP
+func padOTLPID(id string, size int) string {
+	for len(id) < size {
+		id = "0" + id
+	}
+
+	return id
+}
+
+// otlpProtoBuffer is a minimal protobuf wire format encoder sufficient to serialize
+// OTLP trace export requests without depending on the generated protobuf code
+type otlpProtoBuffer struct {
+	bytes.Buffer
+}
+
+func (b *otlpProtoBuffer) tag(field, wireType int) {
+	b.varint(uint64(field<<3 | wireType))
+}
+
+func (b *otlpProtoBuffer) varint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	b.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func (b *otlpProtoBuffer) uint64Field(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+
+	b.tag(field, 0)
+	b.varint(v)
+}
+
+func (b *otlpProtoBuffer) fixed64Field(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+
+	b.tag(field, 1)
+	b.Write(buf[:])
+}
+
+func (b *otlpProtoBuffer) bytesField(field int, v []byte) {
+	b.tag(field, 2)
+	b.varint(uint64(len(v)))
+	b.Write(v)
+}
+
+func (b *otlpProtoBuffer) stringField(field int, v string) {
+	if v == "" {
+		return
+	}
+
+	b.bytesField(field, []byte(v))
+}
+
+func (b *otlpProtoBuffer) hexField(field int, v string) {
+	if v == "" {
+		return
+	}
+
+	id, err := hex.DecodeString(v)
+	if err != nil {
+		return
+	}
+
+	b.bytesField(field, id)
+}
+
+func (b *otlpProtoBuffer) messageField(field int, encode func(*otlpProtoBuffer)) {
+	var msg otlpProtoBuffer
+	encode(&msg)
+
+	b.bytesField(field, msg.Bytes())
+}
+
+// marshalProto encodes the request as an opentelemetry.proto.collector.trace.v1.ExportTraceServiceRequest message
+func (req otlpTracesRequest) marshalProto() []byte {
+	var b otlpProtoBuffer
+
+	for _, rs := range req.ResourceSpans {
+		b.messageField(1, rs.marshalProto)
+	}
+
+	return b.Bytes()
+}
+
+func (rs otlpResourceSpans) marshalProto(b *otlpProtoBuffer) {
+	b.messageField(1, func(b *otlpProtoBuffer) {
+		for _, kv := range rs.Resource.Attributes {
+			b.messageField(1, kv.marshalProto)
+		}
+	})
+
+	for _, ss := range rs.ScopeSpans {
+		b.messageField(2, ss.marshalProto)
+	}
+}
+
+func (ss otlpScopeSpans) marshalProto(b *otlpProtoBuffer) {
+	b.messageField(1, func(b *otlpProtoBuffer) {
+		b.stringField(1, ss.Scope.Name)
+		b.stringField(2, ss.Scope.Version)
+	})
+
+	for _, sp := range ss.Spans {
+		b.messageField(2, sp.marshalProto)
+	}
+}
+
+func (sp otlpSpan) marshalProto(b *otlpProtoBuffer) {
+	b.hexField(1, sp.TraceID)
+	b.hexField(2, sp.SpanID)
+	b.hexField(4, sp.ParentSpanID)
+	b.stringField(5, sp.Name)
+	b.uint64Field(6, uint64(sp.Kind))
+	b.fixed64Field(7, sp.StartTimeUnixNano)
+	b.fixed64Field(8, sp.EndTimeUnixNano)
+
+	for _, kv := range sp.Attributes {
+		b.messageField(9, kv.marshalProto)
+	}
+
+	for _, link := range sp.Links {
+		b.messageField(13, func(b *otlpProtoBuffer) {
+			b.hexField(1, link.TraceID)
+			b.hexField(2, link.SpanID)
+		})
+	}
+
+	b.messageField(15, func(b *otlpProtoBuffer) {
+		b.uint64Field(3, uint64(sp.Status.Code))
+	})
+}
+
+func (kv otlpKeyValue) marshalProto(b *otlpProtoBuffer) {
+	b.stringField(1, kv.Key)
+	b.messageField(2, kv.Value.marshalProto)
+}
+
+func (v otlpAnyValue) marshalProto(b *otlpProtoBuffer) {
+	switch {
+	case v.StringValue != nil:
+		b.bytesField(1, []byte(*v.StringValue))
+	case v.BoolValue != nil:
+		b.tag(2, 0)
+		if *v.BoolValue {
+			b.varint(1)
+		} else {
+			b.varint(0)
+		}
+	case v.IntValue != nil:
+		b.tag(3, 0)
+		b.varint(uint64(*v.IntValue))
+	case v.DoubleValue != nil:
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(*v.DoubleValue))
+
+		b.tag(4, 1)
+		b.Write(buf[:])
+	case v.ArrayValue != nil:
+		b.messageField(5, func(b *otlpProtoBuffer) {
+			for _, el := range v.ArrayValue.Values {
+				b.messageField(1, el.marshalProto)
+			}
+		})
+	}
+}
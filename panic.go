@@ -0,0 +1,89 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"fmt"
+
+	ot "github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// maxPanicLogStackFrames is the maximum number of call stack frames included into the span log record
+const maxPanicLogStackFrames = 10
+
+// RecordPanic records a value recovered from a panic to the span and marks the span as erroneous. The panic message
+// is stored in the errorTag tag, i.e. "http.error" or "rpc.error", unless the tag name is empty. The panic value, its
+// type and the top frames of the stack trace starting at the location of the panic are added to the span logs:
+//
+//	defer func() {
+//		if p := recover(); p != nil {
+//			instana.RecordPanic(sp, p, "http.error")
+//			panic(p)
+//		}
+//	}()
+//
+// RecordPanic is intended to be called by a function deferred in the panicking goroutine.
+func RecordPanic(sp ot.Span, p interface{}, errorTag string) {
+	if sp == nil || p == nil {
+		return
+	}
+
+	stack := capturePanicStack(1)
+
+	if errorTag != "" {
+		sp.SetTag(errorTag, panicMessage(p))
+	}
+
+	errField := otlog.Object("error", p)
+	if err, ok := p.(error); ok {
+		errField = otlog.Error(err)
+	}
+
+	logStack := stack
+	if len(logStack) > maxPanicLogStackFrames {
+		logStack = logStack[:maxPanicLogStackFrames]
+	}
+
+	fields := []otlog.Field{
+		errField,
+		otlog.String("panic.type", fmt.Sprintf("%T", p)),
+		otlog.String("stack", formatStack(logStack)),
+	}
+
+	span, ok := sp.(*spanS)
+	if !ok {
+		sp.LogFields(fields...)
+		return
+	}
+
+	span.logFields(stack, fields...)
+	span.attachStack(stack)
+}
+
+// CapturePanic recovers from a panic and records it to the span with instana.RecordPanic(). If repanic is true,
+// the panic is propagated further once recorded. CapturePanic must be called directly by defer:
+//
+//	sp := sensor.Tracer().StartSpan("rpc-server")
+//	defer sp.Finish()
+//	defer instana.CapturePanic(sp, "rpc.error", true)
+func CapturePanic(sp ot.Span, errorTag string, repanic bool) {
+	p := recover()
+	if p == nil {
+		return
+	}
+
+	RecordPanic(sp, p, errorTag)
+
+	if repanic {
+		panic(p)
+	}
+}
+
+func panicMessage(p interface{}) string {
+	if err, ok := p.(error); ok {
+		return err.Error()
+	}
+
+	return fmt.Sprint(p)
+}
@@ -0,0 +1,119 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapturePanic(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	func() {
+		sp := c.Tracer().StartSpan("rpc-server", ext.SpanKindRPCServer)
+		defer sp.Finish()
+		defer instana.CapturePanic(sp, "rpc.error", false)
+
+		panic(errors.New("something went wrong"))
+	}()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	span, logSpan := spans[0], spans[1]
+	assert.Equal(t, 1, span.Ec)
+
+	require.IsType(t, instana.RPCSpanData{}, span.Data)
+	assert.Equal(t, "something went wrong", span.Data.(instana.RPCSpanData).Tags.Error)
+
+	require.NotEmpty(t, span.Stack)
+	assert.Equal(t, "github.com/instana/go-sensor_test.TestCapturePanic.func1", span.Stack[0].Method)
+
+	require.IsType(t, instana.LogSpanData{}, logSpan.Data)
+	logData := logSpan.Data.(instana.LogSpanData)
+
+	assert.Equal(t, "ERROR", logData.Tags.Level)
+	assert.True(t, strings.HasPrefix(logData.Tags.Message,
+		`error.object: "something went wrong" panic.type: "*errors.errorString" stack: "github.com/instana/go-sensor_test.TestCapturePanic.func1\n\t`),
+		logData.Tags.Message)
+}
+
+func TestCapturePanic_RePanic(t *testing.T) {
+	tracer := mocktracer.New()
+
+	assert.PanicsWithValue(t, 42, func() {
+		sp := tracer.StartSpan("test")
+		defer sp.Finish()
+		defer instana.CapturePanic(sp, "http.error", true)
+
+		panic(42)
+	})
+
+	spans := tracer.FinishedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, "42", spans[0].Tag("http.error"))
+
+	logs := spans[0].Logs()
+	require.Len(t, logs, 1)
+	require.Len(t, logs[0].Fields, 3)
+
+	assert.Equal(t, "error", logs[0].Fields[0].Key)
+	assert.Equal(t, "42", logs[0].Fields[0].ValueString)
+	assert.Equal(t, "panic.type", logs[0].Fields[1].Key)
+	assert.Equal(t, "int", logs[0].Fields[1].ValueString)
+	assert.Equal(t, "stack", logs[0].Fields[2].Key)
+	assert.True(t, strings.HasPrefix(logs[0].Fields[2].ValueString, "github.com/instana/go-sensor_test.TestCapturePanic_RePanic.func1\n\t"))
+}
+
+func TestCapturePanic_NoPanic(t *testing.T) {
+	tracer := mocktracer.New()
+
+	func() {
+		sp := tracer.StartSpan("test")
+		defer sp.Finish()
+		defer instana.CapturePanic(sp, "http.error", true)
+	}()
+
+	spans := tracer.FinishedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Empty(t, spans[0].Tags())
+	assert.Empty(t, spans[0].Logs())
+}
+
+func TestRecordPanic_NoErrorTag(t *testing.T) {
+	tracer := mocktracer.New()
+
+	func() {
+		sp := tracer.StartSpan("test")
+		defer sp.Finish()
+
+		defer func() {
+			if p := recover(); p != nil {
+				instana.RecordPanic(sp, p, "")
+			}
+		}()
+
+		panic("something went wrong")
+	}()
+
+	spans := tracer.FinishedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Empty(t, spans[0].Tags())
+	assert.Len(t, spans[0].Logs(), 1)
+}
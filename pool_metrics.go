@@ -0,0 +1,158 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/instana/go-sensor/acceptor"
+)
+
+// PoolStatistics is a snapshot of the state of a database connection pool
+type PoolStatistics struct {
+	// MaxOpen is the maximum number of connections the pool may open, or 0 if it is unlimited
+	MaxOpen int
+	// Open is the number of established connections, both in use and idle
+	Open int
+	// InUse is the number of connections currently in use
+	InUse int
+	// Idle is the number of idle connections
+	Idle int
+	// WaitCount is the total number of times a connection had to be waited for
+	WaitCount int64
+	// WaitDuration is the total time spent waiting for a connection
+	WaitDuration time.Duration
+}
+
+// PoolStatsFunc returns the current statistics of a connection pool
+type PoolStatsFunc func() PoolStatistics
+
+// RegisterConnectionPool registers a database connection pool, which statistics are collected with every metrics
+// transmission and reported along with the Go process metrics. The pool is identified by the connection details,
+// which may leave out the fields that are unknown. The returned function unregisters the pool and should be called
+// once it is closed.
+//
+// The pools of the databases opened with instana.SQLOpen() and instana.SQLInstrumentAndOpen() are registered
+// automatically and unregistered once the database is closed.
+func RegisterConnectionPool(details DbConnDetails, stats PoolStatsFunc) (unregister func()) {
+	return connectionPools.add(details, stats)
+}
+
+// ConnectionPoolStatistics contains the statistics of a connection pool registered with instana.RegisterConnectionPool()
+type ConnectionPoolStatistics struct {
+	Details DbConnDetails
+	Stats   PoolStatistics
+}
+
+// ConnectionPoolStats returns the current statistics of all registered connection pools
+func ConnectionPoolStats() []ConnectionPoolStatistics {
+	return connectionPools.snapshot()
+}
+
+var connectionPools = &poolRegistry{
+	pools: make(map[uint64]registeredPool),
+}
+
+type registeredPool struct {
+	details DbConnDetails
+	stats   PoolStatsFunc
+}
+
+type poolRegistry struct {
+	mu     sync.Mutex
+	nextID uint64
+	pools  map[uint64]registeredPool
+}
+
+func (r *poolRegistry) add(details DbConnDetails, stats PoolStatsFunc) func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	id := r.nextID
+
+	r.pools[id] = registeredPool{details: details, stats: stats}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			delete(r.pools, id)
+		})
+	}
+}
+
+// snapshot returns the statistics of all registered pools in the order of their registration
+func (r *poolRegistry) snapshot() []ConnectionPoolStatistics {
+	r.mu.Lock()
+	ids := make([]uint64, 0, len(r.pools))
+	for id := range r.pools {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	pools := make([]registeredPool, len(ids))
+	for i, id := range ids {
+		pools[i] = r.pools[id]
+	}
+	r.mu.Unlock()
+
+	// the pool statistics are read without holding the lock, since a stats function may block
+	var stats []ConnectionPoolStatistics
+	for _, p := range pools {
+		stats = append(stats, ConnectionPoolStatistics{
+			Details: p.details,
+			Stats:   p.stats(),
+		})
+	}
+
+	return stats
+}
+
+// collect returns the metrics of all registered pools to be sent to the agent
+func (r *poolRegistry) collect() []acceptor.PoolMetrics {
+	var metrics []acceptor.PoolMetrics
+	for _, p := range r.snapshot() {
+		metrics = append(metrics, p.Stats.toAcceptorMetrics(p.Details))
+	}
+
+	return metrics
+}
+
+func (st PoolStatistics) toAcceptorMetrics(details DbConnDetails) acceptor.PoolMetrics {
+	return acceptor.PoolMetrics{
+		Type:         details.DatabaseName,
+		Host:         details.Host,
+		Port:         details.Port,
+		Schema:       details.Schema,
+		User:         details.User,
+		MaxOpen:      st.MaxOpen,
+		Open:         st.Open,
+		InUse:        st.InUse,
+		Idle:         st.Idle,
+		WaitCount:    st.WaitCount,
+		WaitDuration: st.WaitDuration.Seconds(),
+	}
+}
+
+// sqlDBPoolStats returns a function reading the connection pool statistics of a database/sql database
+func sqlDBPoolStats(db *sql.DB) PoolStatsFunc {
+	return func() PoolStatistics {
+		st := db.Stats()
+
+		return PoolStatistics{
+			MaxOpen:      st.MaxOpenConnections,
+			Open:         st.OpenConnections,
+			InUse:        st.InUse,
+			Idle:         st.Idle,
+			WaitCount:    st.WaitCount,
+			WaitDuration: st.WaitDuration,
+		}
+	}
+}
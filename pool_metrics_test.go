@@ -0,0 +1,129 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/instana/go-sensor/acceptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterConnectionPool(t *testing.T) {
+	unregisterFirst := RegisterConnectionPool(DbConnDetails{
+		DatabaseName: "postgres",
+		Host:         "pool-test-host-1",
+		Port:         "5432",
+		Schema:       "users",
+		User:         "app",
+	}, func() PoolStatistics {
+		return PoolStatistics{
+			MaxOpen:      10,
+			Open:         10,
+			InUse:        8,
+			Idle:         2,
+			WaitCount:    3,
+			WaitDuration: 1500 * time.Millisecond,
+		}
+	})
+	defer unregisterFirst()
+
+	unregisterSecond := RegisterConnectionPool(DbConnDetails{Host: "pool-test-host-2"}, func() PoolStatistics {
+		return PoolStatistics{Open: 1, Idle: 1}
+	})
+
+	pools := findPoolMetrics(newMeter(defaultLogger).collectMetrics().Pools, "pool-test-host-1", "pool-test-host-2")
+	assert.Equal(t, []acceptor.PoolMetrics{
+		{
+			Type:         "postgres",
+			Host:         "pool-test-host-1",
+			Port:         "5432",
+			Schema:       "users",
+			User:         "app",
+			MaxOpen:      10,
+			Open:         10,
+			InUse:        8,
+			Idle:         2,
+			WaitCount:    3,
+			WaitDuration: 1.5,
+		},
+		{
+			Host: "pool-test-host-2",
+			Open: 1,
+			Idle: 1,
+		},
+	}, pools)
+
+	unregisterSecond()
+	assert.NotPanics(t, unregisterSecond)
+
+	pools = findPoolMetrics(connectionPools.collect(), "pool-test-host-1", "pool-test-host-2")
+	require.Len(t, pools, 1)
+	assert.Equal(t, "pool-test-host-1", pools[0].Host)
+}
+
+func TestSQLOpen_PoolMetrics(t *testing.T) {
+	c := InitCollector(&Options{
+		Service:     "go-sensor-test",
+		AgentClient: alwaysReadyClient{},
+		Recorder:    NewTestRecorder(),
+	})
+	defer ShutdownCollector()
+
+	InstrumentSQLDriver(c, "pool_metrics_driver", poolTestDriver{})
+
+	db, err := SQLOpen("pool_metrics_driver", "postgres://app@pool-test-sql-host:5432/users")
+	require.NoError(t, err)
+
+	db.SetMaxOpenConns(5)
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	pools := findPoolMetrics(connectionPools.collect(), "pool-test-sql-host")
+	assert.Equal(t, []acceptor.PoolMetrics{
+		{
+			Type:    "postgres",
+			Host:    "pool-test-sql-host",
+			Port:    "5432",
+			Schema:  "users",
+			User:    "app",
+			MaxOpen: 5,
+			Open:    1,
+			InUse:   1,
+		},
+	}, pools)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+
+	assert.Empty(t, findPoolMetrics(connectionPools.collect(), "pool-test-sql-host"))
+}
+
+// findPoolMetrics returns the metrics of the pools with given hosts, ignoring the pools registered by other tests
+func findPoolMetrics(pools []acceptor.PoolMetrics, hosts ...string) []acceptor.PoolMetrics {
+	var found []acceptor.PoolMetrics
+	for _, p := range pools {
+		for _, host := range hosts {
+			if p.Host == host {
+				found = append(found, p)
+			}
+		}
+	}
+
+	return found
+}
+
+type poolTestDriver struct{}
+
+func (poolTestDriver) Open(string) (driver.Conn, error) { return poolTestConn{}, nil }
+
+type poolTestConn struct{}
+
+func (poolTestConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (poolTestConn) Close() error                        { return nil }
+func (poolTestConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/instana/go-sensor/baggage"
 	"github.com/instana/go-sensor/w3ctrace"
 	ot "github.com/opentracing/opentracing-go"
 )
@@ -38,6 +39,7 @@ func injectTraceContext(sc SpanContext, opaqueCarrier interface{}) error {
 	exstfieldS := FieldS
 	exstfieldL := FieldL
 	exstfieldB := FieldB
+	exstBaggage, exstBaggageValue := baggage.Header, ""
 
 	roCarrier.ForeachKey(func(k, v string) error {
 		switch strings.ToLower(k) {
@@ -47,6 +49,8 @@ func injectTraceContext(sc SpanContext, opaqueCarrier interface{}) error {
 			exstfieldS = k
 		case FieldL:
 			exstfieldL = k
+		case baggage.Header:
+			exstBaggage, exstBaggageValue = k, v
 		default:
 			if strings.HasPrefix(strings.ToLower(k), FieldB) {
 				exstfieldB = string([]rune(k)[:len(FieldB)])
@@ -81,6 +85,7 @@ func injectTraceContext(sc SpanContext, opaqueCarrier interface{}) error {
 		}
 
 		addW3CTraceContext(h, sc)
+		addW3CBaggage(h, sc)
 		addEUMHeaders(h, sc)
 	}
 
@@ -111,17 +116,28 @@ func injectTraceContext(sc SpanContext, opaqueCarrier interface{}) error {
 		carrier.Set(exstfieldB+k, v)
 	}
 
+	// the W3C baggage header of HTTP requests has already been updated, for other carriers, such as message
+	// headers, the baggage is propagated as a single W3C baggage value along with the X-INSTANA-B- items
+	if _, ok := opaqueCarrier.(ot.HTTPHeadersCarrier); !ok && len(sc.Baggage) > 0 {
+		carrier.Set(exstBaggage, mergeW3CBaggage(baggage.Parse(exstBaggageValue), sc).String())
+	}
+
 	return nil
 }
 
 // This method searches for Instana headers (FieldT, FieldS, FieldL and header with name prefixed with FieldB)
 // and try to parse their values. It also tries to extract W3C context and assign it inside returned object. W3C context
 // will be propagated further and can be used as a fallback.
+//
+// The W3C baggage members are merged into the span context baggage, with X-INSTANA-B- values taking precedence
+// over the W3C baggage members with the same key.
 func extractTraceContext(opaqueCarrier interface{}) (SpanContext, error) {
 	spanContext := SpanContext{
 		Baggage: make(map[string]string),
 	}
 
+	var w3cBaggage baggage.Baggage
+
 	carrier, ok := opaqueCarrier.(ot.TextMapReader)
 	if !ok {
 		return spanContext, ot.ErrInvalidCarrier
@@ -161,6 +177,11 @@ func extractTraceContext(opaqueCarrier interface{}) (SpanContext, error) {
 			if !spanContext.Suppressed {
 				spanContext.Correlation = corrData
 			}
+		case baggage.Header:
+			// there might be multiple baggage headers, so the list members are combined
+			for _, m := range baggage.Parse(v).Members() {
+				w3cBaggage = w3cBaggage.Set(m)
+			}
 		default:
 			if strings.HasPrefix(strings.ToLower(k), FieldB) {
 				// preserve original case of the baggage key
@@ -174,6 +195,20 @@ func extractTraceContext(opaqueCarrier interface{}) (SpanContext, error) {
 		return spanContext, err
 	}
 
+	if w3cBaggage.Len() > 0 {
+		// HTTP header names are case-insensitive, so are the X-INSTANA-B- baggage keys
+		instanaKeys := make(map[string]struct{}, len(spanContext.Baggage))
+		for k := range spanContext.Baggage {
+			instanaKeys[strings.ToLower(k)] = struct{}{}
+		}
+
+		for _, m := range w3cBaggage.Members() {
+			if _, ok := instanaKeys[strings.ToLower(m.Key)]; !ok {
+				spanContext.Baggage[m.Key] = m.Value
+			}
+		}
+	}
+
 	// reset the trace IDs if a correlation ID has been provided
 	if spanContext.Correlation.ID != "" {
 		spanContext.TraceIDHi, spanContext.TraceID, spanContext.SpanID = 0, 0, 0
@@ -248,6 +283,27 @@ func pickupW3CTraceContext(h http.Header, sc *SpanContext) {
 	sc.W3CContext = trCtx
 }
 
+// addW3CBaggage merges the span context baggage into the W3C baggage header. The values from the span context
+// override the existing list members with the same key, while keeping their properties.
+func addW3CBaggage(h http.Header, sc SpanContext) {
+	if len(sc.Baggage) == 0 {
+		return
+	}
+
+	bg, _ := baggage.Extract(h)
+	baggage.Inject(mergeW3CBaggage(bg, sc), h)
+}
+
+// mergeW3CBaggage returns the W3C baggage with the span context baggage items added. The values from the span
+// context override the existing list members with the same key, while keeping their properties.
+func mergeW3CBaggage(bg baggage.Baggage, sc SpanContext) baggage.Baggage {
+	for _, m := range baggage.FromMap(sc.Baggage).Members() {
+		bg = bg.SetValue(m.Key, m.Value)
+	}
+
+	return bg
+}
+
 func addEUMHeaders(h http.Header, sc SpanContext) {
 	// Preserve original Server-Timing header values by combining them into a comma-separated list
 	st := append(h["Server-Timing"], "intid;desc="+FormatID(sc.TraceID))
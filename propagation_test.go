@@ -38,6 +38,7 @@ func TestTracer_Inject_HTTPHeaders(t *testing.T) {
 				"X-Instana-S":     {"0000000000003546"},
 				"X-Instana-L":     {"1"},
 				"X-Instana-B-Foo": {"bar"},
+				"Baggage":         {"foo=bar"},
 				"Traceparent":     {"00-00000000000000010000000000002435-0000000000003546-01"},
 				"Tracestate":      {"in=0000000000002435;0000000000003546"},
 				"Server-Timing":   {"intid;desc=0000000000002435"},
@@ -65,11 +66,39 @@ func TestTracer_Inject_HTTPHeaders(t *testing.T) {
 				"X-Instana-S":     {"0000000000003546"},
 				"X-Instana-L":     {"1"},
 				"X-Instana-B-Foo": {"bar"},
+				"Baggage":         {"foo=bar"},
 				"Traceparent":     {"00-00000000000000010000000000002435-0000000000003546-01"},
 				"Tracestate":      {"in=0000000000002435;0000000000003546"},
 				"Server-Timing":   {"intid;desc=0000000000002435"},
 			},
 		},
+		"with w3c baggage": {
+			SpanContext: instana.SpanContext{
+				TraceIDHi: 0x1,
+				TraceID:   0x2435,
+				SpanID:    0x3546,
+				Baggage: map[string]string{
+					"foo":  "bar",
+					"user": "jane doe",
+				},
+			},
+			Headers: http.Header{
+				"Authorization": {"Basic 123"},
+				"Baggage":       {"foo=hello;ttl=60", "tenant=acme"},
+			},
+			Expected: http.Header{
+				"Authorization":    {"Basic 123"},
+				"X-Instana-T":      {"0000000000002435"},
+				"X-Instana-S":      {"0000000000003546"},
+				"X-Instana-L":      {"1"},
+				"X-Instana-B-Foo":  {"bar"},
+				"X-Instana-B-User": {"jane doe"},
+				"Baggage":          {"foo=bar;ttl=60,tenant=acme,user=jane%20doe"},
+				"Traceparent":      {"00-00000000000000010000000000002435-0000000000003546-01"},
+				"Tracestate":       {"in=0000000000002435;0000000000003546"},
+				"Server-Timing":    {"intid;desc=0000000000002435"},
+			},
+		},
 		"with instana trace suppressed": {
 			SpanContext: instana.SpanContext{
 				TraceIDHi:  0x1,
@@ -347,6 +376,41 @@ func TestTracer_Extract_HTTPHeaders(t *testing.T) {
 				Baggage:    map[string]string{},
 			},
 		},
+		"w3c baggage": {
+			Headers: map[string]string{
+				"x-instana-t": "0000000000000000000000010000000000001314",
+				"X-INSTANA-S": "0000000000002435",
+				"X-Instana-L": "1",
+				"baggage":     "user=jane%20doe;ttl=60, tenant=acme",
+			},
+			Expected: instana.SpanContext{
+				TraceIDHi: 0x1,
+				TraceID:   0x1314,
+				SpanID:    0x2435,
+				Baggage: map[string]string{
+					"user":   "jane doe",
+					"tenant": "acme",
+				},
+			},
+		},
+		"w3c baggage, with instana baggage": {
+			Headers: map[string]string{
+				"x-instana-t":        "0000000000000000000000010000000000001314",
+				"X-INSTANA-S":        "0000000000002435",
+				"X-Instana-L":        "1",
+				"X-Instana-B-tenant": "initech",
+				"baggage":            "user=jane,tenant=acme",
+			},
+			Expected: instana.SpanContext{
+				TraceIDHi: 0x1,
+				TraceID:   0x1314,
+				SpanID:    0x2435,
+				Baggage: map[string]string{
+					"user":   "jane",
+					"Tenant": "initech",
+				},
+			},
+		},
 		"w3c trace context, with instana headers": {
 			Headers: map[string]string{
 				"x-instana-t": "10000000000001314",
@@ -530,6 +594,7 @@ func TestTracer_Inject_TextMap_AddValues(t *testing.T) {
 		"x-instana-s":     "0000000000003546",
 		"x-instana-l":     "1",
 		"x-instana-b-foo": "bar",
+		"baggage":         "foo=bar",
 		"key1":            "value1",
 	}, carrier)
 }
@@ -557,6 +622,7 @@ func TestTracer_Inject_TextMap_UpdateValues(t *testing.T) {
 		"X-INSTANA-S":     "0000000000001314",
 		"X-Instana-L":     "1",
 		"X-INSTANA-b-foo": "hello",
+		"Baggage":         "foo=hello;ttl=60,tenant=acme",
 	}
 
 	require.NoError(t, c.Inject(sc, ot.TextMap, ot.TextMapCarrier(carrier)))
@@ -566,6 +632,7 @@ func TestTracer_Inject_TextMap_UpdateValues(t *testing.T) {
 		"X-INSTANA-S":     "0000000000003546",
 		"X-Instana-L":     "1",
 		"X-INSTANA-b-foo": "bar",
+		"Baggage":         "foo=bar;ttl=60,tenant=acme",
 		"key1":            "value1",
 	}, carrier)
 }
@@ -622,6 +689,24 @@ func TestTracer_Extract_TextMap(t *testing.T) {
 				},
 			},
 		},
+		"w3c baggage": {
+			Carrier: map[string]string{
+				"x-instana-t":     "10000000000001314",
+				"X-INSTANA-S":     "2435",
+				"X-Instana-L":     "1",
+				"X-Instana-B-Foo": "bar",
+				"baggage":         "foo=baz,tenant=acme",
+			},
+			Expected: instana.SpanContext{
+				TraceIDHi: 0x1,
+				TraceID:   0x1314,
+				SpanID:    0x2435,
+				Baggage: map[string]string{
+					"Foo":    "bar",
+					"tenant": "acme",
+				},
+			},
+		},
 		"tracing disabled": {
 			Carrier: map[string]string{
 				"Authorization": "Basic 123",
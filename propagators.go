@@ -0,0 +1,443 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	ot "github.com/opentracing/opentracing-go"
+)
+
+// Names of the built-in propagators
+const (
+	// InstanaPropagator is the default propagator that uses the X-INSTANA-* and W3C Trace Context headers.
+	// It is always enabled.
+	InstanaPropagator = "instana"
+	// B3SinglePropagator uses the Zipkin B3 single `b3` header
+	B3SinglePropagator = "b3"
+	// B3MultiPropagator uses the Zipkin B3 multiple X-B3-* headers
+	B3MultiPropagator = "b3multi"
+	// JaegerPropagator uses the Jaeger `uber-trace-id` and `uberctx-*` headers
+	JaegerPropagator = "jaeger"
+)
+
+// B3 and Jaeger header names
+const (
+	b3SingleHeader       = "b3"
+	b3TraceIDHeader      = "x-b3-traceid"
+	b3SpanIDHeader       = "x-b3-spanid"
+	b3ParentSpanIDHeader = "x-b3-parentspanid"
+	b3SampledHeader      = "x-b3-sampled"
+	b3FlagsHeader        = "x-b3-flags"
+
+	jaegerTraceHeader    = "uber-trace-id"
+	jaegerBaggagePrefix  = "uberctx-"
+	jaegerSampledFlag    = 0x01
+	jaegerDebugFlag      = 0x02
+	jaegerDeprecatedSpan = "0"
+)
+
+// Propagator injects the span context into and extracts it from a carrier, such as HTTP headers or message
+// headers. The carrier is an opentracing.TextMapWriter for Inject() and opentracing.TextMapReader for Extract().
+//
+// Extract() is expected to return opentracing.ErrSpanContextNotFound if the carrier does not contain the
+// propagator headers, so that the next propagator could be tried.
+type Propagator interface {
+	Inject(sc SpanContext, carrier interface{}) error
+	Extract(carrier interface{}) (SpanContext, error)
+}
+
+var propagators = struct {
+	mu     sync.RWMutex
+	byName map[string]Propagator
+}{
+	byName: map[string]Propagator{
+		B3SinglePropagator: b3SinglePropagator{},
+		B3MultiPropagator:  b3MultiPropagator{},
+		JaegerPropagator:   jaegerPropagator{},
+	},
+}
+
+// RegisterPropagator makes a propagator available by the provided name, so that it could be enabled via the
+// INSTANA_PROPAGATORS env var. If a propagator with the same name is already registered, it is replaced.
+func RegisterPropagator(name string, p Propagator) {
+	propagators.mu.Lock()
+	defer propagators.mu.Unlock()
+
+	propagators.byName[strings.ToLower(strings.TrimSpace(name))] = p
+}
+
+// NamedPropagator returns a registered propagator by its name. Following propagators are registered by default:
+//
+// * `b3` - the Zipkin B3 single header format
+// * `b3multi` - the Zipkin B3 multiple headers format
+// * `jaeger` - the Jaeger `uber-trace-id` header format
+func NamedPropagator(name string) (Propagator, error) {
+	propagators.mu.RLock()
+	defer propagators.mu.RUnlock()
+
+	p, ok := propagators.byName[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, fmt.Errorf("unknown propagator %q", name)
+	}
+
+	return p, nil
+}
+
+// instanaPropagator propagates the trace context using the X-INSTANA-* headers. For HTTP headers carriers
+// it also uses the W3C Trace Context and Baggage headers.
+type instanaPropagator struct{}
+
+func (instanaPropagator) Inject(sc SpanContext, carrier interface{}) error {
+	return injectTraceContext(sc, carrier)
+}
+
+func (instanaPropagator) Extract(carrier interface{}) (SpanContext, error) {
+	return extractTraceContext(carrier)
+}
+
+// compositePropagator injects the trace context using all of its propagators. The context is extracted
+// using the first propagator that finds it in the carrier.
+type compositePropagator []Propagator
+
+func (c compositePropagator) Inject(sc SpanContext, carrier interface{}) error {
+	for _, p := range c {
+		if err := p.Inject(sc, carrier); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c compositePropagator) Extract(carrier interface{}) (SpanContext, error) {
+	var firstErr error
+	for _, p := range c {
+		sc, err := p.Extract(carrier)
+		if err == nil {
+			return sc, nil
+		}
+
+		if firstErr == nil && err != ot.ErrSpanContextNotFound {
+			firstErr = err
+		}
+	}
+
+	if firstErr != nil {
+		return SpanContext{}, firstErr
+	}
+
+	return SpanContext{}, ot.ErrSpanContextNotFound
+}
+
+// b3SinglePropagator implements the B3 single header format as defined by https://github.com/openzipkin/b3-propagation
+//
+//	b3: {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}
+type b3SinglePropagator struct{}
+
+func (b3SinglePropagator) Inject(sc SpanContext, carrier interface{}) error {
+	w, ok := carrier.(ot.TextMapWriter)
+	if !ok {
+		return ot.ErrInvalidCarrier
+	}
+
+	if sc.TraceIDHi == 0 && sc.TraceID == 0 {
+		if sc.Suppressed {
+			w.Set(b3SingleHeader, "0")
+		}
+
+		return nil
+	}
+
+	v := formatB3TraceID(sc) + "-" + FormatID(sc.SpanID) + "-" + formatB3Sampled(sc)
+	if sc.ParentID != 0 {
+		v += "-" + FormatID(sc.ParentID)
+	}
+
+	w.Set(b3SingleHeader, v)
+
+	return nil
+}
+
+func (b3SinglePropagator) Extract(carrier interface{}) (SpanContext, error) {
+	r, ok := carrier.(ot.TextMapReader)
+	if !ok {
+		return SpanContext{}, ot.ErrInvalidCarrier
+	}
+
+	var header string
+	r.ForeachKey(func(k, v string) error {
+		if strings.EqualFold(k, b3SingleHeader) {
+			header = strings.TrimSpace(v)
+		}
+
+		return nil
+	})
+
+	if header == "" {
+		return SpanContext{}, ot.ErrSpanContextNotFound
+	}
+
+	parts := strings.Split(header, "-")
+
+	// sampling decision only
+	if len(parts) == 1 {
+		suppressed, err := parseB3SamplingState(parts[0])
+		if err != nil {
+			return SpanContext{}, err
+		}
+
+		return SpanContext{Suppressed: suppressed, Baggage: make(map[string]string)}, nil
+	}
+
+	if len(parts) > 4 {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	sc, err := parseB3IDs(parts[0], parts[1])
+	if err != nil {
+		return SpanContext{}, err
+	}
+
+	if len(parts) > 2 {
+		if sc.Suppressed, err = parseB3SamplingState(parts[2]); err != nil {
+			return SpanContext{}, err
+		}
+	}
+
+	return sc, nil
+}
+
+// b3MultiPropagator implements the B3 multiple headers format as defined by https://github.com/openzipkin/b3-propagation
+type b3MultiPropagator struct{}
+
+func (b3MultiPropagator) Inject(sc SpanContext, carrier interface{}) error {
+	w, ok := carrier.(ot.TextMapWriter)
+	if !ok {
+		return ot.ErrInvalidCarrier
+	}
+
+	if sc.TraceIDHi != 0 || sc.TraceID != 0 {
+		w.Set(b3TraceIDHeader, formatB3TraceID(sc))
+		w.Set(b3SpanIDHeader, FormatID(sc.SpanID))
+
+		if sc.ParentID != 0 {
+			w.Set(b3ParentSpanIDHeader, FormatID(sc.ParentID))
+		}
+	}
+
+	w.Set(b3SampledHeader, formatB3Sampled(sc))
+
+	return nil
+}
+
+func (b3MultiPropagator) Extract(carrier interface{}) (SpanContext, error) {
+	r, ok := carrier.(ot.TextMapReader)
+	if !ok {
+		return SpanContext{}, ot.ErrInvalidCarrier
+	}
+
+	var traceID, spanID, sampled, flags string
+	r.ForeachKey(func(k, v string) error {
+		switch strings.ToLower(k) {
+		case b3TraceIDHeader:
+			traceID = strings.TrimSpace(v)
+		case b3SpanIDHeader:
+			spanID = strings.TrimSpace(v)
+		case b3SampledHeader:
+			sampled = strings.TrimSpace(v)
+		case b3FlagsHeader:
+			flags = strings.TrimSpace(v)
+		}
+
+		return nil
+	})
+
+	if traceID == "" && spanID == "" && sampled == "" && flags == "" {
+		return SpanContext{}, ot.ErrSpanContextNotFound
+	}
+
+	var (
+		sc  = SpanContext{Baggage: make(map[string]string)}
+		err error
+	)
+	if traceID != "" || spanID != "" {
+		if sc, err = parseB3IDs(traceID, spanID); err != nil {
+			return SpanContext{}, err
+		}
+	}
+
+	// the debug flag implies an accept sampling decision
+	if flags == "1" {
+		return sc, nil
+	}
+
+	if sampled != "" {
+		if sc.Suppressed, err = parseB3SamplingState(sampled); err != nil {
+			return SpanContext{}, err
+		}
+	}
+
+	if sc.IsZero() {
+		return SpanContext{}, ot.ErrSpanContextNotFound
+	}
+
+	return sc, nil
+}
+
+func formatB3TraceID(sc SpanContext) string {
+	if sc.TraceIDHi == 0 {
+		return FormatID(sc.TraceID)
+	}
+
+	return FormatLongID(sc.TraceIDHi, sc.TraceID)
+}
+
+func formatB3Sampled(sc SpanContext) string {
+	if sc.Suppressed {
+		return "0"
+	}
+
+	return "1"
+}
+
+func parseB3IDs(traceID, spanID string) (SpanContext, error) {
+	if (len(traceID) != 16 && len(traceID) != 32) || len(spanID) != 16 {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	traceIDHi, traceIDLo, err := ParseLongID(traceID)
+	if err != nil {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	sid, err := ParseID(spanID)
+	if err != nil {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	if (traceIDHi == 0 && traceIDLo == 0) || sid == 0 {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	return SpanContext{
+		TraceIDHi: traceIDHi,
+		TraceID:   traceIDLo,
+		SpanID:    sid,
+		Baggage:   make(map[string]string),
+	}, nil
+}
+
+// parseB3SamplingState parses the B3 sampling state and returns whether the trace is suppressed
+func parseB3SamplingState(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "1", "d", "true":
+		return false, nil
+	case "0", "false":
+		return true, nil
+	default:
+		return false, ot.ErrSpanContextCorrupted
+	}
+}
+
+// jaegerPropagator implements the Jaeger propagation format as defined by
+// https://www.jaegertracing.io/docs/latest/client-libraries/#propagation-format
+//
+//	uber-trace-id: {trace-id}:{span-id}:{parent-span-id}:{flags}
+//	uberctx-{baggage-key}: {baggage-value}
+type jaegerPropagator struct{}
+
+func (jaegerPropagator) Inject(sc SpanContext, carrier interface{}) error {
+	w, ok := carrier.(ot.TextMapWriter)
+	if !ok {
+		return ot.ErrInvalidCarrier
+	}
+
+	if sc.TraceIDHi != 0 || sc.TraceID != 0 {
+		var flags uint8
+		if !sc.Suppressed {
+			flags |= jaegerSampledFlag
+		}
+
+		w.Set(jaegerTraceHeader, formatB3TraceID(sc)+":"+FormatID(sc.SpanID)+":"+jaegerDeprecatedSpan+":"+strconv.FormatUint(uint64(flags), 16))
+	}
+
+	for k, v := range sc.Baggage {
+		w.Set(jaegerBaggagePrefix+k, url.QueryEscape(v))
+	}
+
+	return nil
+}
+
+func (jaegerPropagator) Extract(carrier interface{}) (SpanContext, error) {
+	r, ok := carrier.(ot.TextMapReader)
+	if !ok {
+		return SpanContext{}, ot.ErrInvalidCarrier
+	}
+
+	var (
+		header  string
+		baggage = make(map[string]string)
+	)
+	r.ForeachKey(func(k, v string) error {
+		lk := strings.ToLower(k)
+
+		switch {
+		case lk == jaegerTraceHeader:
+			header = v
+		case strings.HasPrefix(lk, jaegerBaggagePrefix) && len(k) > len(jaegerBaggagePrefix):
+			if unescaped, err := url.QueryUnescape(v); err == nil {
+				v = unescaped
+			}
+
+			baggage[k[len(jaegerBaggagePrefix):]] = v
+		}
+
+		return nil
+	})
+
+	if header == "" {
+		return SpanContext{}, ot.ErrSpanContextNotFound
+	}
+
+	// some clients URL-encode the header value
+	if unescaped, err := url.QueryUnescape(header); err == nil {
+		header = unescaped
+	}
+
+	parts := strings.Split(strings.TrimSpace(header), ":")
+	if len(parts) != 4 || len(parts[0]) == 0 || len(parts[0]) > 32 || len(parts[1]) == 0 || len(parts[1]) > 16 {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	traceIDHi, traceIDLo, err := ParseLongID(parts[0])
+	if err != nil {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	spanID, err := ParseID(parts[1])
+	if err != nil {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	if (traceIDHi == 0 && traceIDLo == 0) || spanID == 0 {
+		return SpanContext{}, ot.ErrSpanContextCorrupted
+	}
+
+	return SpanContext{
+		TraceIDHi:  traceIDHi,
+		TraceID:    traceIDLo,
+		SpanID:     spanID,
+		Suppressed: flags&(jaegerSampledFlag|jaegerDebugFlag) == 0,
+		Baggage:    baggage,
+	}, nil
+}
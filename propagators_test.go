@@ -0,0 +1,355 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPropagatorsCollector(t *testing.T, recorder instana.SpanRecorder, names ...string) instana.TracerLogger {
+	t.Helper()
+
+	var ps []instana.Propagator
+	for _, name := range names {
+		p, err := instana.NamedPropagator(name)
+		require.NoError(t, err)
+
+		ps = append(ps, p)
+	}
+
+	return instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		Tracer: instana.TracerOptions{
+			Propagators: ps,
+		},
+	})
+}
+
+func TestNamedPropagator_Unknown(t *testing.T) {
+	_, err := instana.NamedPropagator("xray")
+	assert.Error(t, err)
+}
+
+func TestTracer_Inject_Propagators(t *testing.T) {
+	sc := instana.SpanContext{
+		TraceIDHi: 0x1,
+		TraceID:   0x2435,
+		SpanID:    0x3546,
+		ParentID:  0x1314,
+		Baggage: map[string]string{
+			"tenant": "acme corp",
+		},
+	}
+
+	examples := map[string]struct {
+		SpanContext instana.SpanContext
+		Expected    http.Header
+	}{
+		instana.B3SinglePropagator: {
+			SpanContext: sc,
+			Expected: http.Header{
+				"B3": {"00000000000000010000000000002435-0000000000003546-1-0000000000001314"},
+			},
+		},
+		instana.B3MultiPropagator: {
+			SpanContext: sc,
+			Expected: http.Header{
+				"X-B3-Traceid":      {"00000000000000010000000000002435"},
+				"X-B3-Spanid":       {"0000000000003546"},
+				"X-B3-Parentspanid": {"0000000000001314"},
+				"X-B3-Sampled":      {"1"},
+			},
+		},
+		instana.JaegerPropagator: {
+			SpanContext: sc,
+			Expected: http.Header{
+				"Uber-Trace-Id":  {"00000000000000010000000000002435:0000000000003546:0:1"},
+				"Uberctx-Tenant": {"acme+corp"},
+			},
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			c := newPropagatorsCollector(t, instana.NewTestRecorder(), name)
+			defer instana.ShutdownCollector()
+
+			headers := http.Header{}
+			require.NoError(t, c.Tracer().Inject(example.SpanContext, ot.HTTPHeaders, ot.HTTPHeadersCarrier(headers)))
+
+			for k, v := range example.Expected {
+				assert.Equal(t, v, headers[k], k)
+			}
+
+			// Instana headers are always injected
+			assert.Equal(t, "0000000000002435", headers.Get(instana.FieldT))
+			assert.Equal(t, "0000000000003546", headers.Get(instana.FieldS))
+			assert.NotEmpty(t, headers.Get("traceparent"))
+		})
+	}
+}
+
+func TestTracer_Inject_Propagators_Suppressed(t *testing.T) {
+	c := newPropagatorsCollector(t, instana.NewTestRecorder(), instana.B3SinglePropagator, instana.B3MultiPropagator, instana.JaegerPropagator)
+	defer instana.ShutdownCollector()
+
+	headers := http.Header{}
+	require.NoError(t, c.Tracer().Inject(instana.SpanContext{
+		TraceID:    0x2435,
+		SpanID:     0x3546,
+		Suppressed: true,
+	}, ot.HTTPHeaders, ot.HTTPHeadersCarrier(headers)))
+
+	assert.Equal(t, "0000000000002435-0000000000003546-0", headers.Get("b3"))
+	assert.Equal(t, "0", headers.Get("X-B3-Sampled"))
+	assert.Equal(t, "0000000000002435:0000000000003546:0:0", headers.Get("uber-trace-id"))
+}
+
+func TestTracer_Extract_Propagators(t *testing.T) {
+	examples := map[string]struct {
+		Propagator string
+		Headers    map[string]string
+		Expected   instana.SpanContext
+	}{
+		"b3 single": {
+			Propagator: instana.B3SinglePropagator,
+			Headers: map[string]string{
+				"b3": "80f198ee56343ba864fe8b2a57d3eff7-e457b5a2e4d86bd1-1-05e3ac9a4f6e3b90",
+			},
+			Expected: instana.SpanContext{
+				TraceIDHi: -0x7f0e6711a9cbc458,
+				TraceID:   0x64fe8b2a57d3eff7,
+				SpanID:    -0x1ba84a5d1b27942f,
+				Baggage:   map[string]string{},
+			},
+		},
+		"b3 single, 64-bit trace id, no sampling state": {
+			Propagator: instana.B3SinglePropagator,
+			Headers: map[string]string{
+				"b3": "64fe8b2a57d3eff7-e457b5a2e4d86bd1",
+			},
+			Expected: instana.SpanContext{
+				TraceID: 0x64fe8b2a57d3eff7,
+				SpanID:  -0x1ba84a5d1b27942f,
+				Baggage: map[string]string{},
+			},
+		},
+		"b3 single, not sampled": {
+			Propagator: instana.B3SinglePropagator,
+			Headers: map[string]string{
+				"b3": "64fe8b2a57d3eff7-e457b5a2e4d86bd1-0",
+			},
+			Expected: instana.SpanContext{
+				TraceID:    0x64fe8b2a57d3eff7,
+				SpanID:     -0x1ba84a5d1b27942f,
+				Suppressed: true,
+				Baggage:    map[string]string{},
+			},
+		},
+		"b3 single, debug": {
+			Propagator: instana.B3SinglePropagator,
+			Headers: map[string]string{
+				"b3": "64fe8b2a57d3eff7-e457b5a2e4d86bd1-d",
+			},
+			Expected: instana.SpanContext{
+				TraceID: 0x64fe8b2a57d3eff7,
+				SpanID:  -0x1ba84a5d1b27942f,
+				Baggage: map[string]string{},
+			},
+		},
+		"b3 single, deny only": {
+			Propagator: instana.B3SinglePropagator,
+			Headers: map[string]string{
+				"b3": "0",
+			},
+			Expected: instana.SpanContext{
+				Suppressed: true,
+				Baggage:    map[string]string{},
+			},
+		},
+		"b3 multi": {
+			Propagator: instana.B3MultiPropagator,
+			Headers: map[string]string{
+				"X-B3-TraceId":      "80f198ee56343ba864fe8b2a57d3eff7",
+				"X-B3-SpanId":       "e457b5a2e4d86bd1",
+				"X-B3-ParentSpanId": "05e3ac9a4f6e3b90",
+				"X-B3-Sampled":      "1",
+			},
+			Expected: instana.SpanContext{
+				TraceIDHi: -0x7f0e6711a9cbc458,
+				TraceID:   0x64fe8b2a57d3eff7,
+				SpanID:    -0x1ba84a5d1b27942f,
+				Baggage:   map[string]string{},
+			},
+		},
+		"b3 multi, not sampled": {
+			Propagator: instana.B3MultiPropagator,
+			Headers: map[string]string{
+				"X-B3-TraceId": "64fe8b2a57d3eff7",
+				"X-B3-SpanId":  "e457b5a2e4d86bd1",
+				"X-B3-Sampled": "0",
+			},
+			Expected: instana.SpanContext{
+				TraceID:    0x64fe8b2a57d3eff7,
+				SpanID:     -0x1ba84a5d1b27942f,
+				Suppressed: true,
+				Baggage:    map[string]string{},
+			},
+		},
+		"b3 multi, debug": {
+			Propagator: instana.B3MultiPropagator,
+			Headers: map[string]string{
+				"X-B3-TraceId": "64fe8b2a57d3eff7",
+				"X-B3-SpanId":  "e457b5a2e4d86bd1",
+				"X-B3-Sampled": "0",
+				"X-B3-Flags":   "1",
+			},
+			Expected: instana.SpanContext{
+				TraceID: 0x64fe8b2a57d3eff7,
+				SpanID:  -0x1ba84a5d1b27942f,
+				Baggage: map[string]string{},
+			},
+		},
+		"jaeger": {
+			Propagator: instana.JaegerPropagator,
+			Headers: map[string]string{
+				"uber-trace-id":  "80f198ee56343ba864fe8b2a57d3eff7:e457b5a2e4d86bd1:0:1",
+				"uberctx-tenant": "acme%20corp",
+			},
+			Expected: instana.SpanContext{
+				TraceIDHi: -0x7f0e6711a9cbc458,
+				TraceID:   0x64fe8b2a57d3eff7,
+				SpanID:    -0x1ba84a5d1b27942f,
+				Baggage: map[string]string{
+					"Tenant": "acme corp",
+				},
+			},
+		},
+		"jaeger, url-encoded, unpadded, not sampled": {
+			Propagator: instana.JaegerPropagator,
+			Headers: map[string]string{
+				"uber-trace-id": "2435%3A3546%3A0%3A0",
+			},
+			Expected: instana.SpanContext{
+				TraceID:    0x2435,
+				SpanID:     0x3546,
+				Suppressed: true,
+				Baggage:    map[string]string{},
+			},
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			c := newPropagatorsCollector(t, instana.NewTestRecorder(), example.Propagator)
+			defer instana.ShutdownCollector()
+
+			headers := http.Header{}
+			for k, v := range example.Headers {
+				headers.Set(k, v)
+			}
+
+			sc, err := c.Tracer().Extract(ot.HTTPHeaders, ot.HTTPHeadersCarrier(headers))
+			require.NoError(t, err)
+
+			assert.Equal(t, example.Expected, sc)
+		})
+	}
+}
+
+func TestTracer_Extract_Propagators_Corrupted(t *testing.T) {
+	examples := map[string]struct {
+		Propagator string
+		Headers    map[string]string
+	}{
+		"b3 single, malformed trace id": {
+			Propagator: instana.B3SinglePropagator,
+			Headers:    map[string]string{"b3": "xyz-e457b5a2e4d86bd1-1"},
+		},
+		"b3 single, invalid sampling state": {
+			Propagator: instana.B3SinglePropagator,
+			Headers:    map[string]string{"b3": "64fe8b2a57d3eff7-e457b5a2e4d86bd1-x"},
+		},
+		"b3 multi, missing span id": {
+			Propagator: instana.B3MultiPropagator,
+			Headers:    map[string]string{"X-B3-TraceId": "64fe8b2a57d3eff7"},
+		},
+		"jaeger, missing parts": {
+			Propagator: instana.JaegerPropagator,
+			Headers:    map[string]string{"uber-trace-id": "2435:3546"},
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			c := newPropagatorsCollector(t, instana.NewTestRecorder(), example.Propagator)
+			defer instana.ShutdownCollector()
+
+			headers := http.Header{}
+			for k, v := range example.Headers {
+				headers.Set(k, v)
+			}
+
+			_, err := c.Tracer().Extract(ot.HTTPHeaders, ot.HTTPHeadersCarrier(headers))
+			assert.Equal(t, ot.ErrSpanContextCorrupted, err)
+		})
+	}
+}
+
+func TestTracer_Extract_Propagators_InstanaFirst(t *testing.T) {
+	c := newPropagatorsCollector(t, instana.NewTestRecorder(), instana.B3SinglePropagator)
+	defer instana.ShutdownCollector()
+
+	headers := http.Header{}
+	headers.Set(instana.FieldT, "0000000000001314")
+	headers.Set(instana.FieldS, "0000000000002435")
+	headers.Set("b3", "64fe8b2a57d3eff7-e457b5a2e4d86bd1-1")
+
+	sc, err := c.Tracer().Extract(ot.HTTPHeaders, ot.HTTPHeadersCarrier(headers))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(0x1314), sc.(instana.SpanContext).TraceID)
+	assert.Equal(t, int64(0x2435), sc.(instana.SpanContext).SpanID)
+}
+
+func TestTracer_Extract_Propagators_NotEnabled(t *testing.T) {
+	c := newPropagatorsCollector(t, instana.NewTestRecorder())
+	defer instana.ShutdownCollector()
+
+	headers := http.Header{}
+	headers.Set("b3", "64fe8b2a57d3eff7-e457b5a2e4d86bd1-1")
+
+	_, err := c.Tracer().Extract(ot.HTTPHeaders, ot.HTTPHeadersCarrier(headers))
+	assert.Equal(t, ot.ErrSpanContextNotFound, err)
+}
+
+func TestTracingHandlerFunc_B3Propagation(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := newPropagatorsCollector(t, recorder, instana.B3MultiPropagator)
+	defer instana.ShutdownCollector()
+
+	h := instana.TracingHandlerFunc(c, "/test", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("X-B3-TraceId", "0000000000001314")
+	req.Header.Set("X-B3-SpanId", "0000000000002435")
+	req.Header.Set("X-B3-Sampled", "1")
+
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, int64(0x1314), spans[0].TraceID)
+	assert.Equal(t, int64(0x2435), spans[0].ParentID)
+}
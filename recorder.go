@@ -6,10 +6,16 @@ package instana
 import (
 	"context"
 	"fmt"
+	"runtime"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// maxRecorderShards limits the number of span buffer shards, regardless of the number of available CPUs
+const maxRecorderShards = 64
+
 // A SpanRecorder handles all of the `RawSpan` data generated via an
 // associated `Tracer` (see `NewStandardTracer`) instance. It also names
 // the containing process and provides access to a straightforward tag map.
@@ -22,29 +28,38 @@ type SpanRecorder interface {
 
 // Recorder accepts spans, processes and queues them
 // for delivery to the backend.
+//
+// Finished spans are distributed over a set of fixed-size ring buffers, so that the concurrently finishing
+// spans do not contend for a single lock. Once a buffer is full, its oldest span is overwritten. The buffered
+// spans are sent to the agent by a single background goroutine, that is stopped by (*Recorder).Close().
 type Recorder struct {
+	// Deprecated: the recorder does not use this mutex anymore. It is only kept for backward compatibility.
 	sync.RWMutex
-	spans    []Span
+
+	buf      atomic.Pointer[spanBuffer]
+	seq      atomic.Uint64
 	testMode bool
+
+	// flushMu serializes flushes and guards the retry queue
+	flushMu sync.Mutex
+	// retry contains the spans that failed to be sent during the last flush
+	retry []Span
+
+	flushCh   chan struct{}
+	done      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
 }
 
 // NewRecorder initializes a new span recorder
 func NewRecorder() *Recorder {
-	recorder := &Recorder{}
-
-	go func(rec *Recorder) {
-		ticker := time.NewTicker(1 * time.Second)
-		for range ticker.C {
-
-			if isAgentReady() {
-				go func(r *Recorder) {
-					if err := r.Flush(context.Background()); err != nil {
-						sensor.logger.Error("failed to flush the spans:  ", err.Error())
-					}
-				}(rec)
-			}
-		}
-	}(recorder)
+	recorder := &Recorder{
+		flushCh: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	go recorder.run(time.Second)
 
 	return recorder
 }
@@ -57,6 +72,46 @@ func NewTestRecorder() *Recorder {
 	}
 }
 
+// run is the flusher loop that sends the buffered spans to the agent every interval and whenever
+// the number of buffered spans reaches Options.ForceTransmissionStartingAt
+func (r *Recorder) run(interval time.Duration) {
+	defer close(r.stopped)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			if !isAgentReady() {
+				continue
+			}
+		case <-r.flushCh:
+		}
+
+		if err := r.Flush(context.Background()); err != nil {
+			// the sensor might have been shut down meanwhile
+			if s, sErr := getSensor(); sErr == nil {
+				s.logger.Error("failed to flush the spans: ", err.Error())
+			}
+		}
+	}
+}
+
+// Close stops the background flusher goroutine and waits for it to exit. Queued spans are not sent,
+// use Flush() to do so before closing the recorder. The recorder used by the collector is closed by
+// instana.ShutdownCollector().
+func (r *Recorder) Close() {
+	if r.done == nil {
+		return
+	}
+
+	r.closeOnce.Do(func() { close(r.done) })
+	<-r.stopped
+}
+
 // RecordSpan accepts spans to be recorded and added to the span queue
 // for eventual reporting to the host agent.
 func (r *Recorder) RecordSpan(span *spanS) {
@@ -68,32 +123,43 @@ func (r *Recorder) RecordSpan(span *spanS) {
 
 	// If we're not announced and not in test mode then just return
 	if !r.testMode && !s.Agent().Ready() {
+		recorderStats.rejectedBeforeReady.Add(1)
 		return
 	}
 
-	maxBuffered := s.options.MaxBufferedSpans
-	forceAt := s.options.ForceTransmissionStartingAt
+	// the span processors have already been applied to the spans finished by the tracer
+	var doc Span
+	if span.doc != nil {
+		doc = *span.doc
+	} else {
+		doc = newSpan(span)
+		if !processSpanDocument(&doc) {
+			return
+		}
+	}
 
-	r.Lock()
-	defer r.Unlock()
+	buf := r.buffer(s.options.MaxBufferedSpans)
+	seq := r.seq.Add(1)
 
-	if len(r.spans) == maxBuffered {
-		r.spans = r.spans[1:]
+	if buf.shards[seq&buf.mask].push(seq, doc) {
+		recorderStats.evicted.Add(1)
+	} else {
+		buf.count.Add(1)
+		recorderStats.queued.Add(1)
 	}
+	recorderStats.recorded.Add(1)
 
-	r.spans = append(r.spans, newSpan(span))
-
-	if r.testMode || !s.Agent().Ready() {
+	if r.testMode || r.flushCh == nil {
 		return
 	}
 
-	if len(r.spans) >= forceAt {
-		s.logger.Debug("forcing ", len(r.spans), "span(s) to the agent")
-		go func() {
-			if err := r.Flush(context.Background()); err != nil {
-				s.logger.Error("failed to flush the spans: ", err.Error())
-			}
-		}()
+	if int(buf.count.Load()) >= s.options.ForceTransmissionStartingAt {
+		// a flush is already pending if the channel is full
+		select {
+		case r.flushCh <- struct{}{}:
+			s.logger.Debug("forcing ", buf.count.Load(), " span(s) to the agent")
+		default:
+		}
 	}
 }
 
@@ -101,44 +167,57 @@ func (r *Recorder) RecordSpan(span *spanS) {
 //
 //	Used only in tests currently.
 func (r *Recorder) QueuedSpansCount() int {
-	r.RLock()
-	defer r.RUnlock()
-	return len(r.spans)
+	r.flushMu.Lock()
+	n := len(r.retry)
+	r.flushMu.Unlock()
+
+	if buf := r.buf.Load(); buf != nil {
+		n += int(buf.count.Load())
+	}
+
+	return n
 }
 
 // GetQueuedSpans returns a copy of the queued spans and clears the queue.
 func (r *Recorder) GetQueuedSpans() []Span {
-	r.Lock()
-	defer r.Unlock()
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
 
-	// Copy queued spans
-	queuedSpans := make([]Span, len(r.spans))
-	copy(queuedSpans, r.spans)
-
-	// and clear out the source
-	r.clearQueuedSpans()
-	return queuedSpans
+	return r.drain()
 }
 
 // Flush sends queued spans to the agent
 func (r *Recorder) Flush(ctx context.Context) error {
-	spansToSend := r.GetQueuedSpans()
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	spansToSend := r.drain()
 	if len(spansToSend) == 0 {
 		return nil
 	}
 
 	s, err := getSensor()
 	if err != nil {
+		r.retry = spansToSend
+		recorderStats.queued.Add(int64(len(spansToSend)))
+
 		return fmt.Errorf("recorder: %s", err.Error())
 	}
 
-	if err := s.Agent().SendSpans(spansToSend); err != nil {
-		r.Lock()
-		defer r.Unlock()
+	start := time.Now()
+	err = s.Agent().SendSpans(spansToSend)
+	recorderStats.observeFlush(len(spansToSend), time.Since(start), err)
+
+	if err != nil {
+		// keep failed spans to send them first during the next flush, evicting the oldest
+		// ones whenever their number exceeds options.MaxBufferedSpans
+		if maxBuffered := s.options.MaxBufferedSpans; maxBuffered > 0 && len(spansToSend) > maxBuffered {
+			recorderStats.evicted.Add(uint64(len(spansToSend) - maxBuffered))
+			spansToSend = spansToSend[len(spansToSend)-maxBuffered:]
+		}
 
-		// put failed spans in front of the queue to make sure they are evicted first
-		// whenever the queue length exceeds options.MaxBufferedSpans
-		r.spans = append(spansToSend, r.spans...)
+		r.retry = spansToSend
+		recorderStats.queued.Add(int64(len(spansToSend)))
 
 		return fmt.Errorf("failed to send collected spans to the agent: %s", err)
 	}
@@ -146,12 +225,138 @@ func (r *Recorder) Flush(ctx context.Context) error {
 	return nil
 }
 
-// clearQueuedSpans brings the span queue to empty/0/nada
+// buffer returns the span buffer of the recorder, initializing it on first use
+func (r *Recorder) buffer(maxBuffered int) *spanBuffer {
+	if buf := r.buf.Load(); buf != nil {
+		return buf
+	}
+
+	r.buf.CompareAndSwap(nil, newSpanBuffer(maxBuffered))
+
+	return r.buf.Load()
+}
+
+// drain removes all queued spans, including the ones left from a failed flush, and returns them in the
+// order they were recorded.
 //
-//	This function doesn't take the Lock so make sure to have
-//	the write lock before calling.
-//	This is meant to be called from GetQueuedSpans which handles
-//	locking.
-func (r *Recorder) clearQueuedSpans() {
-	r.spans = r.spans[:0]
+//	This function doesn't take the flushMu lock, so make sure to have
+//	it locked before calling.
+func (r *Recorder) drain() []Span {
+	queued := r.retry
+	r.retry = nil
+
+	if buf := r.buf.Load(); buf != nil {
+		queued = append(queued, buf.drain()...)
+	}
+
+	recorderStats.queued.Add(-int64(len(queued)))
+
+	if queued == nil {
+		queued = []Span{}
+	}
+
+	return queued
+}
+
+// spanBuffer is a set of ring buffers. Spans are distributed across the shards in a round-robin manner,
+// so that each shard keeps roughly the same number of spans and the oldest spans are evicted first.
+type spanBuffer struct {
+	shards []spanBufferShard
+	mask   uint64
+	count  atomic.Int64
+}
+
+func newSpanBuffer(maxBuffered int) *spanBuffer {
+	if maxBuffered < 1 {
+		maxBuffered = 1
+	}
+
+	n := 1
+	for n < runtime.GOMAXPROCS(0) && n < maxRecorderShards && 2*n <= maxBuffered {
+		n *= 2
+	}
+
+	buf := &spanBuffer{
+		shards: make([]spanBufferShard, n),
+		mask:   uint64(n - 1),
+	}
+
+	shardSize := (maxBuffered + n - 1) / n
+	for i := range buf.shards {
+		buf.shards[i].entries = make([]spanBufferEntry, shardSize)
+	}
+
+	return buf
+}
+
+// drain empties all shards and returns their content sorted by the recording order
+func (buf *spanBuffer) drain() []Span {
+	var entries []spanBufferEntry
+	for i := range buf.shards {
+		entries = buf.shards[i].drain(entries)
+	}
+
+	buf.count.Add(-int64(len(entries)))
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].seq < entries[j].seq })
+
+	spans := make([]Span, len(entries))
+	for i := range entries {
+		spans[i] = entries[i].span
+	}
+
+	return spans
+}
+
+type spanBufferEntry struct {
+	seq  uint64
+	span Span
+}
+
+// spanBufferShard is a fixed-size ring buffer of spans
+type spanBufferShard struct {
+	mu      sync.Mutex
+	entries []spanBufferEntry
+	head    int
+	len     int
+
+	// pad the shard to a cache line to avoid false sharing between neighbouring shards
+	_ [64]byte
+}
+
+// push adds a span to the buffer, overwriting the oldest one if the buffer is full. The return value
+// reports whether a span has been evicted.
+func (sh *spanBufferShard) push(seq uint64, span Span) bool {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	size := len(sh.entries)
+	if sh.len == size {
+		sh.entries[sh.head] = spanBufferEntry{seq, span}
+		sh.head = (sh.head + 1) % size
+
+		return true
+	}
+
+	sh.entries[(sh.head+sh.len)%size] = spanBufferEntry{seq, span}
+	sh.len++
+
+	return false
+}
+
+// drain appends the buffered entries to dst in the order they were added and empties the buffer
+func (sh *spanBufferShard) drain(dst []spanBufferEntry) []spanBufferEntry {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	size := len(sh.entries)
+	for i := 0; i < sh.len; i++ {
+		idx := (sh.head + i) % size
+		dst = append(dst, sh.entries[idx])
+		sh.entries[idx] = spanBufferEntry{}
+	}
+
+	sh.head, sh.len = 0, 0
+
+	return dst
 }
@@ -0,0 +1,235 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingAgentClient struct {
+	alwaysReadyClient
+	sent atomic.Int64
+}
+
+func (c *countingAgentClient) SendSpans(spans []Span) error {
+	c.sent.Add(int64(len(spans)))
+	return nil
+}
+
+func TestRecorder_Eviction(t *testing.T) {
+	recorder := NewTestRecorder()
+	c := InitCollector(&Options{
+		AgentClient:      alwaysReadyClient{},
+		Recorder:         recorder,
+		MaxBufferedSpans: 4,
+	})
+	defer ShutdownCollector()
+
+	for i := 0; i < 10; i++ {
+		c.StartSpan(fmt.Sprintf("span-%d", i)).Finish()
+	}
+
+	assert.Equal(t, 4, recorder.QueuedSpansCount())
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 4)
+
+	var names []string
+	for _, sp := range spans {
+		names = append(names, sp.Data.(SDKSpanData).Tags.Name)
+	}
+
+	assert.Equal(t, []string{"span-6", "span-7", "span-8", "span-9"}, names)
+	assert.Equal(t, 0, recorder.QueuedSpansCount())
+}
+
+func TestRecorder_ConcurrentRecordSpan(t *testing.T) {
+	recorder := NewTestRecorder()
+	c := InitCollector(&Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer ShutdownCollector()
+
+	const goroutines, spansPerGoroutine = 8, 100
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < spansPerGoroutine; j++ {
+				c.StartSpan("test-span").Finish()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, recorder.GetQueuedSpans(), goroutines*spansPerGoroutine)
+}
+
+func TestRecorder_ForceTransmission(t *testing.T) {
+	client := &countingAgentClient{}
+
+	recorder := NewRecorder()
+	defer recorder.Close()
+
+	c := InitCollector(&Options{
+		AgentClient:                 client,
+		Recorder:                    recorder,
+		ForceTransmissionStartingAt: 5,
+	})
+	defer ShutdownCollector()
+
+	for i := 0; i < 5; i++ {
+		c.StartSpan("test-span").Finish()
+	}
+
+	assert.Eventually(t, func() bool {
+		return client.sent.Load() == 5
+	}, 500*time.Millisecond, 10*time.Millisecond, "spans should be flushed before the next tick")
+}
+
+func TestRecorder_Close(t *testing.T) {
+	recorder := NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		recorder.Close()
+		recorder.Close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the flusher goroutine did not exit")
+	}
+
+	select {
+	case <-recorder.stopped:
+	default:
+		t.Error("the flusher goroutine is still running")
+	}
+
+	// closing a test recorder is a noop
+	NewTestRecorder().Close()
+}
+
+func TestRecorder_Flush_Retry(t *testing.T) {
+	client := &flakyAgentClient{err: assert.AnError}
+
+	recorder := NewTestRecorder()
+	c := InitCollector(&Options{
+		AgentClient: client,
+		Recorder:    recorder,
+	})
+	defer ShutdownCollector()
+
+	c.StartSpan("first").Finish()
+	require.Error(t, recorder.Flush(context.Background()))
+
+	c.StartSpan("second").Finish()
+	assert.Equal(t, 2, recorder.QueuedSpansCount())
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "first", spans[0].Data.(SDKSpanData).Tags.Name, "failed spans are sent first")
+	assert.Equal(t, "second", spans[1].Data.(SDKSpanData).Tags.Name)
+}
+
+// mutexRecorder is the single-lock slice-based recorder implementation used as a baseline for the benchmarks
+type mutexRecorder struct {
+	sync.RWMutex
+	spans []Span
+}
+
+func (r *mutexRecorder) RecordSpan(span *spanS) {
+	maxBuffered := sensor.options.MaxBufferedSpans
+
+	r.Lock()
+	defer r.Unlock()
+
+	if len(r.spans) == maxBuffered {
+		r.spans = r.spans[1:]
+	}
+
+	r.spans = append(r.spans, newSpan(span))
+}
+
+func (r *mutexRecorder) Flush(context.Context) error { return nil }
+
+func benchmarkRecordSpan(b *testing.B, recorder SpanRecorder) {
+	c := InitCollector(&Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer ShutdownCollector()
+
+	sp := c.StartSpan("test-span").(*spanS)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			recorder.RecordSpan(sp)
+		}
+	})
+}
+
+func BenchmarkRecorder_RecordSpan(b *testing.B) {
+	benchmarkRecordSpan(b, NewTestRecorder())
+}
+
+func BenchmarkRecorder_RecordSpan_SingleLock(b *testing.B) {
+	benchmarkRecordSpan(b, &mutexRecorder{})
+}
+
+func TestShutdownCollector_StopsRecorder(t *testing.T) {
+	before := countRecorderGoroutines(t)
+
+	for i := 0; i < 5; i++ {
+		opts := &Options{
+			Service:     "go-sensor-test",
+			AgentClient: alwaysReadyClient{},
+		}
+
+		c := InitCollector(opts)
+		c.StartSpan("test").Finish()
+
+		rec, ok := opts.Recorder.(*Recorder)
+		require.True(t, ok)
+
+		ShutdownCollector()
+
+		select {
+		case <-rec.stopped:
+		default:
+			t.Fatal("the recorder flusher is still running after the collector shutdown")
+		}
+	}
+
+	assert.Equal(t, before, countRecorderGoroutines(t))
+}
+
+// countRecorderGoroutines returns the number of running recorder flusher goroutines
+func countRecorderGoroutines(t *testing.T) int {
+	t.Helper()
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, pprof.Lookup("goroutine").WriteTo(buf, 2))
+
+	return bytes.Count(buf.Bytes(), []byte("instana.(*Recorder).run("))
+}
@@ -0,0 +1,190 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/instana/go-sensor/acceptor"
+)
+
+// Histogram bucket upper bounds used by the recorder self-telemetry
+var (
+	flushLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	batchSizeBuckets    = []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+)
+
+// RecorderStatistics contains the span recorder self-telemetry collected since the process start
+type RecorderStatistics struct {
+	// Recorded is the number of spans added to the recorder queue
+	Recorded uint64
+	// Evicted is the number of queued spans removed to make room for the new ones once
+	// the queue has reached Options.MaxBufferedSpans
+	Evicted uint64
+	// RejectedBeforeReady is the number of spans discarded because the host agent was not ready
+	// to accept them yet
+	RejectedBeforeReady uint64
+	// Sent is the number of spans handed over to the agent client without an error
+	Sent uint64
+	// Failed is the number of spans the agent client failed to send. These spans are put back
+	// into the queue and retried with the next flush
+	Failed uint64
+	// Dropped is the number of spans discarded by the agent client, since the payload exceeded
+	// the maximum size accepted by the agent
+	Dropped uint64
+	// Queued is the number of spans currently waiting in the recorder queue
+	Queued int64
+	// FlushLatency is the distribution of the time it took to send a batch of spans, in seconds
+	FlushLatency HistogramStatistics
+	// BatchSize is the distribution of the number of spans sent with a single flush
+	BatchSize HistogramStatistics
+}
+
+// HistogramStatistics is a snapshot of a histogram of observed values
+type HistogramStatistics struct {
+	// Count is the total number of observations
+	Count uint64
+	// Sum is the sum of all observed values
+	Sum float64
+	// Buckets contains the cumulative counters of observations less than or equal to the bucket upper bound
+	Buckets []HistogramBucket
+}
+
+// HistogramBucket is a cumulative histogram bucket
+type HistogramBucket struct {
+	UpperBound float64
+	Count      uint64
+}
+
+// RecorderStats returns the self-telemetry of the span recorder, which allows to find out whether
+// any tracing data is being lost
+func RecorderStats() RecorderStatistics {
+	return recorderStats.snapshot()
+}
+
+var recorderStats = newRecorderTelemetry()
+
+type recorderTelemetry struct {
+	recorded            atomic.Uint64
+	evicted             atomic.Uint64
+	rejectedBeforeReady atomic.Uint64
+	sent                atomic.Uint64
+	failed              atomic.Uint64
+	dropped             atomic.Uint64
+	queued              atomic.Int64
+
+	flushLatency *histogram
+	batchSize    *histogram
+}
+
+func newRecorderTelemetry() *recorderTelemetry {
+	return &recorderTelemetry{
+		flushLatency: newHistogram(flushLatencyBuckets),
+		batchSize:    newHistogram(batchSizeBuckets),
+	}
+}
+
+func (t *recorderTelemetry) observeFlush(n int, d time.Duration, err error) {
+	t.flushLatency.Observe(d.Seconds())
+	t.batchSize.Observe(float64(n))
+
+	if err != nil {
+		t.failed.Add(uint64(n))
+		return
+	}
+
+	t.sent.Add(uint64(n))
+}
+
+func (t *recorderTelemetry) snapshot() RecorderStatistics {
+	return RecorderStatistics{
+		Recorded:            t.recorded.Load(),
+		Evicted:             t.evicted.Load(),
+		RejectedBeforeReady: t.rejectedBeforeReady.Load(),
+		Sent:                t.sent.Load(),
+		Failed:              t.failed.Load(),
+		Dropped:             t.dropped.Load(),
+		Queued:              t.queued.Load(),
+		FlushLatency:        t.flushLatency.Snapshot(),
+		BatchSize:           t.batchSize.Snapshot(),
+	}
+}
+
+// histogram is a lock-free fixed-bucket histogram
+type histogram struct {
+	bounds []float64
+	// counts has an extra bucket for the values greater than the last bound
+	counts []atomic.Uint64
+	count  atomic.Uint64
+	sum    atomic.Uint64 // float64 bits
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{
+		bounds: bounds,
+		counts: make([]atomic.Uint64, len(bounds)+1),
+	}
+}
+
+func (h *histogram) Observe(v float64) {
+	i := 0
+	for i < len(h.bounds) && v > h.bounds[i] {
+		i++
+	}
+
+	h.counts[i].Add(1)
+	h.count.Add(1)
+
+	for {
+		old := h.sum.Load()
+		if h.sum.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+v)) {
+			return
+		}
+	}
+}
+
+func (h *histogram) Snapshot() HistogramStatistics {
+	st := HistogramStatistics{
+		Count:   h.count.Load(),
+		Sum:     math.Float64frombits(h.sum.Load()),
+		Buckets: make([]HistogramBucket, len(h.bounds)),
+	}
+
+	var cumulative uint64
+	for i, bound := range h.bounds {
+		cumulative += h.counts[i].Load()
+		st.Buckets[i] = HistogramBucket{UpperBound: bound, Count: cumulative}
+	}
+
+	return st
+}
+
+func (st RecorderStatistics) toAcceptorMetrics() *acceptor.RecorderMetrics {
+	return &acceptor.RecorderMetrics{
+		Recorded:            st.Recorded,
+		Evicted:             st.Evicted,
+		RejectedBeforeReady: st.RejectedBeforeReady,
+		Sent:                st.Sent,
+		Failed:              st.Failed,
+		Dropped:             st.Dropped,
+		Queued:              st.Queued,
+		FlushLatency:        st.FlushLatency.toAcceptorMetrics(),
+		BatchSize:           st.BatchSize.toAcceptorMetrics(),
+	}
+}
+
+func (st HistogramStatistics) toAcceptorMetrics() acceptor.HistogramMetrics {
+	m := acceptor.HistogramMetrics{
+		Count:   st.Count,
+		Sum:     st.Sum,
+		Buckets: make([]acceptor.HistogramBucket, len(st.Buckets)),
+	}
+
+	for i, b := range st.Buckets {
+		m.Buckets[i] = acceptor.HistogramBucket{UpperBound: b.UpperBound, Count: b.Count}
+	}
+
+	return m
+}
@@ -0,0 +1,112 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type flakyAgentClient struct {
+	alwaysReadyClient
+	err error
+}
+
+func (c *flakyAgentClient) SendSpans(spans []Span) error { return c.err }
+
+func TestRecorderStats(t *testing.T) {
+	client := &flakyAgentClient{err: errors.New("agent is not available")}
+
+	recorder := NewTestRecorder()
+	c := InitCollector(&Options{
+		AgentClient:      client,
+		Recorder:         recorder,
+		MaxBufferedSpans: 2,
+	})
+	defer ShutdownCollector()
+
+	initial := RecorderStats()
+
+	for i := 0; i < 3; i++ {
+		c.StartSpan("test-span").Finish()
+	}
+
+	st := RecorderStats()
+	assert.Equal(t, uint64(3), st.Recorded-initial.Recorded)
+	assert.Equal(t, uint64(1), st.Evicted-initial.Evicted)
+	assert.Equal(t, int64(2), st.Queued-initial.Queued)
+
+	require.Error(t, recorder.Flush(context.Background()))
+
+	st = RecorderStats()
+	assert.Equal(t, uint64(2), st.Failed-initial.Failed)
+	assert.Equal(t, uint64(0), st.Sent-initial.Sent)
+	assert.Equal(t, int64(2), st.Queued-initial.Queued, "failed spans are put back into the queue")
+
+	client.err = nil
+	require.NoError(t, recorder.Flush(context.Background()))
+
+	st = RecorderStats()
+	assert.Equal(t, uint64(2), st.Sent-initial.Sent)
+	assert.Equal(t, int64(0), st.Queued-initial.Queued)
+	assert.Equal(t, uint64(2), st.BatchSize.Count-initial.BatchSize.Count)
+	assert.Equal(t, float64(4), st.BatchSize.Sum-initial.BatchSize.Sum)
+	assert.Equal(t, uint64(2), st.FlushLatency.Count-initial.FlushLatency.Count)
+}
+
+func TestRecorderStats_RejectedBeforeReady(t *testing.T) {
+	recorder := NewRecorder()
+	c := InitCollector(&Options{
+		AgentClient: notReadyAgentClient{},
+		Recorder:    recorder,
+	})
+	defer ShutdownCollector()
+
+	initial := RecorderStats()
+
+	sp := c.StartSpan("test-span").(*spanS)
+	recorder.RecordSpan(sp)
+
+	st := RecorderStats()
+	assert.Equal(t, uint64(1), st.RejectedBeforeReady-initial.RejectedBeforeReady)
+	assert.Equal(t, uint64(0), st.Recorded-initial.Recorded)
+}
+
+type notReadyAgentClient struct {
+	alwaysReadyClient
+}
+
+func (notReadyAgentClient) Ready() bool { return false }
+
+func TestHistogram(t *testing.T) {
+	h := newHistogram([]float64{1, 10})
+
+	for _, v := range []float64{0.5, 1, 5, 20} {
+		h.Observe(v)
+	}
+
+	assert.Equal(t, HistogramStatistics{
+		Count: 4,
+		Sum:   26.5,
+		Buckets: []HistogramBucket{
+			{UpperBound: 1, Count: 2},
+			{UpperBound: 10, Count: 3},
+		},
+	}, h.Snapshot())
+}
+
+func TestMeterCollectMetrics_Recorder(t *testing.T) {
+	recorderStats.observeFlush(10, 30*time.Millisecond, nil)
+
+	metrics := newMeter(defaultLogger).collectMetrics()
+
+	require.NotNil(t, metrics.Recorder)
+	assert.NotZero(t, metrics.Recorder.Sent)
+	assert.NotZero(t, metrics.Recorder.BatchSize.Count)
+	assert.Len(t, metrics.Recorder.FlushLatency.Buckets, len(flushLatencyBuckets))
+}
@@ -0,0 +1,154 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/instana/go-sensor/secrets"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+const (
+	// secretValuePatternsConfig is the INSTANA_SECRET_VALUES config type that lists the built-in value patterns
+	secretValuePatternsConfig = "patterns"
+	// secretValueRegexConfig is the INSTANA_SECRET_VALUES config type that lists custom regular expressions
+	secretValueRegexConfig = "regex"
+)
+
+// ValueRedactor replaces sensitive data found in string values, such as URLs, database statements,
+// HTTP headers and log messages
+type ValueRedactor interface {
+	Redact(s string) string
+}
+
+// redactedValueTags is the set of span tags which values are passed through the value redactor
+var redactedValueTags = map[string]struct{}{
+	string(ext.HTTPUrl):     {},
+	"http.params":           {},
+	"http.header":           {},
+	string(ext.DBStatement): {},
+	"mysql.stmt":            {},
+	"pg.stmt":               {},
+	"couchbase.sql":         {},
+	"mongo.query":           {},
+	"log.message":           {},
+}
+
+// NewValueRedactor returns a value redactor for a list of built-in value patterns and custom regular expressions.
+// See secrets.NamedValuePattern() for the list of supported pattern names.
+func NewValueRedactor(patterns []string, exprs []string) (ValueRedactor, error) {
+	var vps []secrets.ValuePattern
+
+	for _, name := range patterns {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+
+		p, err := secrets.NamedValuePattern(name)
+		if err != nil {
+			return nil, err
+		}
+
+		vps = append(vps, p)
+	}
+
+	for _, s := range exprs {
+		if s == "" {
+			continue
+		}
+
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("malformed secret value regexp %q: %s", s, err)
+		}
+
+		vps = append(vps, secrets.NewRegexpValuePattern(re))
+	}
+
+	if len(vps) == 0 {
+		return nil, errors.New("no secret value patterns provided")
+	}
+
+	return secrets.NewValueRedactor(vps...), nil
+}
+
+// redactTagValues replaces sensitive data found in the values of span tags that may contain it.
+//
+//	This method doesn't take the lock, so make sure to have it
+//	locked before calling.
+func (r *spanS) redactTagValues(red ValueRedactor) {
+	if red == nil {
+		return
+	}
+
+	for k, v := range r.Tags {
+		if _, ok := redactedValueTags[k]; !ok {
+			continue
+		}
+
+		switch v := v.(type) {
+		case string:
+			if k == "http.params" {
+				r.Tags[k] = redactQueryValues(v, red)
+				continue
+			}
+
+			r.Tags[k] = red.Redact(v)
+		case map[string]string:
+			redacted := make(map[string]string, len(v))
+			for hk, hv := range v {
+				redacted[hk] = red.Redact(hv)
+			}
+
+			r.Tags[k] = redacted
+		}
+	}
+}
+
+// redactErrorEvents replaces sensitive data found in the messages of the error events attached to the span.
+//
+//	This method doesn't take the lock, so make sure to have it
+//	locked before calling.
+func (r *spanS) redactErrorEvents(red ValueRedactor) {
+	if red == nil {
+		return
+	}
+
+	for i := range r.Errors {
+		r.Errors[i].Message = red.Redact(r.Errors[i].Message)
+
+		for j := range r.Errors[i].Causes {
+			r.Errors[i].Causes[j].Message = red.Redact(r.Errors[i].Causes[j].Message)
+		}
+	}
+}
+
+// redactQueryValues redacts the values of an URL-encoded query string. A query that cannot be parsed
+// is redacted as a whole.
+func redactQueryValues(s string, red ValueRedactor) string {
+	params, err := url.ParseQuery(s)
+	if err != nil {
+		return red.Redact(s)
+	}
+
+	var changed bool
+	for _, vs := range params {
+		for i, v := range vs {
+			if redacted := red.Redact(v); redacted != v {
+				vs[i] = redacted
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return s
+	}
+
+	return params.Encode()
+}
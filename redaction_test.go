@@ -0,0 +1,112 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueRedactor_SpanTags(t *testing.T) {
+	red, err := instana.NewValueRedactor([]string{"email", "credit-card", "bearer-token"}, []string{`ACC-\d+`})
+	require.NoError(t, err)
+
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		Tracer: instana.TracerOptions{
+			ValueRedactor: red,
+		},
+	})
+	defer instana.ShutdownCollector()
+
+	sp := c.StartSpan("g.http", ext.SpanKindRPCServer, ot.Tags{
+		"http.url":    "/users/jdoe@example.com",
+		"http.params": "card=4111111111111111&q=shoes",
+		"http.header": map[string]string{"Authorization": "Bearer abc123"},
+	})
+	c.StartSpan("query", ext.SpanKindRPCClient, ot.ChildOf(sp.Context()), ot.Tags{
+		string(ext.DBStatement): "SELECT * FROM users WHERE email = 'jdoe@example.com'",
+	}).Finish()
+
+	sp.LogKV("error", "failed to charge ACC-123456")
+	sp.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 3)
+
+	sdkData, ok := spans[0].Data.(instana.SDKSpanData)
+	require.True(t, ok)
+	assert.Equal(t, ot.Tags{
+		"span.kind":    ext.SpanKindRPCClientEnum,
+		"db.statement": "SELECT * FROM users WHERE email = '<redacted>'",
+	}, sdkData.Tags.Custom["tags"])
+
+	httpData, ok := spans[1].Data.(instana.HTTPSpanData)
+	require.True(t, ok)
+	assert.Equal(t, "/users/<redacted>", httpData.Tags.URL)
+	assert.Equal(t, "card=%3Credacted%3E&q=shoes", httpData.Tags.Params)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer <redacted>"}, httpData.Tags.Headers)
+
+	require.Len(t, spans[1].Errors, 1)
+	assert.Equal(t, "failed to charge <redacted>", spans[1].Errors[0].Message)
+
+	logData, ok := spans[2].Data.(instana.LogSpanData)
+	require.True(t, ok)
+	assert.Equal(t, `error: "failed to charge <redacted>"`, logData.Tags.Message)
+}
+
+func TestValueRedactor_ErrorEvents(t *testing.T) {
+	red, err := instana.NewValueRedactor([]string{"email"}, nil)
+	require.NoError(t, err)
+
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		Tracer: instana.TracerOptions{
+			ValueRedactor: red,
+		},
+	})
+	defer instana.ShutdownCollector()
+
+	sp := c.StartSpan("entry", ext.SpanKindRPCServer)
+	sp.LogFields(otlog.Error(fmt.Errorf("failed to notify user: %w", errors.New("no such user jdoe@example.com"))))
+	sp.Finish()
+
+	entrySpan := findSpanByName(t, recorder.GetQueuedSpans(), string(instana.SDKSpanType))
+	require.Len(t, entrySpan.Errors, 1)
+
+	ev := entrySpan.Errors[0]
+	assert.Equal(t, "failed to notify user: no such user <redacted>", ev.Message)
+	assert.Equal(t, []instana.ErrorCause{
+		{Type: "*errors.errorString", Message: "no such user <redacted>"},
+	}, ev.Causes)
+}
+
+func TestNewValueRedactor_Error(t *testing.T) {
+	examples := map[string]struct {
+		Patterns []string
+		Regex    []string
+	}{
+		"unknown pattern":  {Patterns: []string{"ssn"}},
+		"malformed regexp": {Regex: []string{"("}},
+		"empty":            {},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			_, err := instana.NewValueRedactor(example.Patterns, example.Regex)
+			assert.Error(t, err)
+		})
+	}
+}
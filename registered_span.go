@@ -131,14 +131,28 @@ func (st RegisteredSpanType) TagsNames() map[string]struct{} {
 			"http.url": yes, string(ext.HTTPUrl): yes,
 			"http.status": yes, "http.status_code": yes,
 			"http.method": yes, string(ext.HTTPMethod): yes,
-			"http.path":     yes,
-			"http.params":   yes,
-			"http.header":   yes,
-			"http.path_tpl": yes,
-			"http.route_id": yes,
-			"http.host":     yes,
-			"http.protocol": yes,
-			"http.error":    yes,
+			"http.path":        yes,
+			"http.params":      yes,
+			"http.path_params": yes,
+			"http.header":      yes,
+			"http.path_tpl":    yes,
+			"http.route_id":    yes,
+			"http.host":        yes,
+			"http.protocol":    yes,
+			"http.error":       yes,
+			"http.proto":       yes,
+			"http.remote_ip":   yes,
+			"http.conn_reused": yes,
+
+			"http.request_content_length":  yes,
+			"http.response_content_length": yes,
+			"http.response_bytes":          yes,
+
+			"http.timing.pool_wait": yes,
+			"http.timing.dns":       yes,
+			"http.timing.connect":   yes,
+			"http.timing.tls":       yes,
+			"http.timing.server":    yes,
 		}
 	case RPCServerSpanType, RPCClientSpanType:
 		return map[string]struct{}{
@@ -251,12 +265,14 @@ func (st RegisteredSpanType) TagsNames() map[string]struct{} {
 		}
 	case PostgreSQLSpanType:
 		return map[string]struct{}{
-			"pg.db":    yes,
-			"pg.user":  yes,
-			"pg.stmt":  yes,
-			"pg.host":  yes,
-			"pg.port":  yes,
-			"pg.error": yes,
+			"pg.db":            yes,
+			"pg.user":          yes,
+			"pg.stmt":          yes,
+			"pg.host":          yes,
+			"pg.port":          yes,
+			"pg.error":         yes,
+			"pg.rows":          yes,
+			"pg.rows_affected": yes,
 		}
 	case CouchbaseSpanType:
 		return map[string]struct{}{
@@ -268,12 +284,14 @@ func (st RegisteredSpanType) TagsNames() map[string]struct{} {
 		}
 	case MySQLSpanType:
 		return map[string]struct{}{
-			"mysql.db":    yes,
-			"mysql.user":  yes,
-			"mysql.stmt":  yes,
-			"mysql.host":  yes,
-			"mysql.port":  yes,
-			"mysql.error": yes,
+			"mysql.db":            yes,
+			"mysql.user":          yes,
+			"mysql.stmt":          yes,
+			"mysql.host":          yes,
+			"mysql.port":          yes,
+			"mysql.error":         yes,
+			"mysql.rows":          yes,
+			"mysql.rows_affected": yes,
 		}
 	case RedisSpanType:
 		return map[string]struct{}{
@@ -354,6 +372,8 @@ type HTTPSpanTags struct {
 	Path string `json:"path,omitempty"`
 	// Params are the request query string parameters
 	Params string `json:"params,omitempty"`
+	// PathParams are the values of the wildcards in the matched route pattern
+	PathParams string `json:"path_params,omitempty"`
 	// Headers are the captured request/response headers
 	Headers map[string]string `json:"header,omitempty"`
 	// PathTemplate is the raw template string used to route the request
@@ -366,6 +386,34 @@ type HTTPSpanTags struct {
 	Protocol string `json:"protocol,omitempty"`
 	// The message describing an error occurred during the request handling
 	Error string `json:"error,omitempty"`
+	// ProtocolVersion is the protocol version used to send the client request, i.e. HTTP/1.1 or HTTP/2.0
+	ProtocolVersion string `json:"proto,omitempty"`
+	// RemoteIP is the IP address of the server the client request has been sent to
+	RemoteIP string `json:"remote_ip,omitempty"`
+	// ConnReused indicates whether the client request has been sent over a previously used connection
+	ConnReused bool `json:"conn_reused,omitempty"`
+	// RequestContentLength is the length of the client request body as declared by the request
+	RequestContentLength int `json:"request_content_length,omitempty"`
+	// ResponseContentLength is the length of the response body as declared by the server
+	ResponseContentLength int `json:"response_content_length,omitempty"`
+	// ResponseBytes is the number of response body bytes read by the client
+	ResponseBytes int `json:"response_bytes,omitempty"`
+	// Timings are the durations of the client request phases collected with instana.WithHTTPTrace()
+	Timings *HTTPTimings `json:"timings,omitempty"`
+}
+
+// HTTPTimings contains the durations of the HTTP client request phases in milliseconds
+type HTTPTimings struct {
+	// PoolWait is the time spent waiting for an idle connection to become available
+	PoolWait float64 `json:"pool_wait,omitempty"`
+	// DNS is the duration of the DNS lookup
+	DNS float64 `json:"dns,omitempty"`
+	// Connect is the time needed to establish a new TCP connection
+	Connect float64 `json:"connect,omitempty"`
+	// TLS is the duration of the TLS handshake
+	TLS float64 `json:"tls,omitempty"`
+	// Server is the time between sending the request and receiving the first byte of the response
+	Server float64 `json:"server,omitempty"`
 }
 
 // newHTTPSpanTags extracts HTTP-specific span tags from a tracer span
@@ -383,6 +431,8 @@ func newHTTPSpanTags(span *spanS) HTTPSpanTags {
 			readStringTag(&tags.Path, v)
 		case "http.params":
 			readStringTag(&tags.Params, v)
+		case "http.path_params":
+			readStringTag(&tags.PathParams, v)
 		case "http.header":
 			if m, ok := v.(map[string]string); ok {
 				tags.Headers = m
@@ -397,12 +447,42 @@ func newHTTPSpanTags(span *spanS) HTTPSpanTags {
 			readStringTag(&tags.Protocol, v)
 		case "http.error":
 			readStringTag(&tags.Error, v)
+		case "http.proto":
+			readStringTag(&tags.ProtocolVersion, v)
+		case "http.remote_ip":
+			readStringTag(&tags.RemoteIP, v)
+		case "http.conn_reused":
+			readBoolTag(&tags.ConnReused, v)
+		case "http.request_content_length":
+			readIntTag(&tags.RequestContentLength, v)
+		case "http.response_content_length":
+			readIntTag(&tags.ResponseContentLength, v)
+		case "http.response_bytes":
+			readIntTag(&tags.ResponseBytes, v)
+		case "http.timing.pool_wait":
+			readFloatTag(&tags.timings().PoolWait, v)
+		case "http.timing.dns":
+			readFloatTag(&tags.timings().DNS, v)
+		case "http.timing.connect":
+			readFloatTag(&tags.timings().Connect, v)
+		case "http.timing.tls":
+			readFloatTag(&tags.timings().TLS, v)
+		case "http.timing.server":
+			readFloatTag(&tags.timings().Server, v)
 		}
 	}
 
 	return tags
 }
 
+func (tags *HTTPSpanTags) timings() *HTTPTimings {
+	if tags.Timings == nil {
+		tags.Timings = &HTTPTimings{}
+	}
+
+	return tags.Timings
+}
+
 // RPCSpanData represents the `data` section of an RPC span sent within an OT span document
 type RPCSpanData struct {
 	SpanData
@@ -1485,6 +1565,10 @@ type PostgreSQLSpanTags struct {
 	DB   string `json:"db"`
 	User string `json:"user"`
 	Stmt string `json:"stmt"`
+	// Rows is the number of rows read from the result set of a query
+	Rows int `json:"rows,omitempty"`
+	// RowsAffected is the number of rows affected by a statement
+	RowsAffected int `json:"rows_affected,omitempty"`
 
 	Error string `json:"error,omitempty"`
 }
@@ -1503,6 +1587,10 @@ func newPostgreSQLSpanTags(span *spanS) PostgreSQLSpanTags {
 			readStringTag(&tags.Stmt, v)
 		case "pg.user":
 			readStringTag(&tags.User, v)
+		case "pg.rows":
+			readIntTag(&tags.Rows, v)
+		case "pg.rows_affected":
+			readIntTag(&tags.RowsAffected, v)
 		case "pg.error":
 			readStringTag(&tags.Error, v)
 		}
@@ -1640,6 +1728,10 @@ type MySQLSpanTags struct {
 	DB   string `json:"db"`
 	User string `json:"user"`
 	Stmt string `json:"stmt"`
+	// Rows is the number of rows read from the result set of a query
+	Rows int `json:"rows,omitempty"`
+	// RowsAffected is the number of rows affected by a statement
+	RowsAffected int `json:"rows_affected,omitempty"`
 
 	Error string `json:"error,omitempty"`
 }
@@ -1658,6 +1750,10 @@ func newMySQLSpanTags(span *spanS) MySQLSpanTags {
 			readStringTag(&tags.Stmt, v)
 		case "mysql.user":
 			readStringTag(&tags.User, v)
+		case "mysql.rows":
+			readIntTag(&tags.Rows, v)
+		case "mysql.rows_affected":
+			readIntTag(&tags.RowsAffected, v)
 		case "mysql.error":
 			readStringTag(&tags.Error, v)
 		}
@@ -1718,6 +1814,8 @@ func newAZFSpanTags(span *spanS) AZFSpanTags {
 			readStringTag(&tags.Trigger, v)
 		case "azf.runtime":
 			readStringTag(&tags.Runtime, v)
+		case "azf.error":
+			readStringTag(&tags.Error, v)
 		}
 	}
 
@@ -0,0 +1,215 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+)
+
+const (
+	// AlwaysSampler samples every trace
+	AlwaysSampler = "always"
+	// NeverSampler drops every trace
+	NeverSampler = "never"
+	// ProbabilisticSampler samples a fixed fraction of traces. The argument provided to instana.NamedSampler()
+	// is the sampling rate, a floating point number between 0 and 1
+	ProbabilisticSampler = "probabilistic"
+	// RateLimitingSampler samples up to a fixed number of traces per second. The argument provided to
+	// instana.NamedSampler() is the maximum number of traces per second
+	RateLimitingSampler = "rate-limiting"
+)
+
+// SamplingParameters contain the data available to a Sampler when the sampling decision is made
+type SamplingParameters struct {
+	// Operation is the operation name of the span being started
+	Operation string
+	// TraceIDHi is the higher 4 bytes of a 128-bit trace ID of the span being started
+	TraceIDHi int64
+	// TraceID is the trace ID of the span being started
+	TraceID int64
+	// Parent is the span context of the parent span, nil if the span is a root span
+	Parent *SpanContext
+	// Tags contains the tags provided when the span was started
+	Tags ot.Tags
+}
+
+// Sampler makes a head-based sampling decision for new spans. Spans that are not sampled are not sent
+// to the agent, and the decision is propagated downstream via the X-INSTANA-L header and the W3C
+// traceparent sampled flag.
+type Sampler interface {
+	ShouldSample(p SamplingParameters) bool
+}
+
+// SamplerFunc is an adapter to allow the use of ordinary functions as samplers
+type SamplerFunc func(p SamplingParameters) bool
+
+// ShouldSample calls f(p)
+func (f SamplerFunc) ShouldSample(p SamplingParameters) bool {
+	return f(p)
+}
+
+// NamedSampler returns a parent-based sampler of the given type. The root sampler is initialized
+// using the provided argument.
+//
+// See instana.AlwaysSampler, instana.NeverSampler, instana.ProbabilisticSampler and
+// instana.RateLimitingSampler for the list of supported samplers.
+func NamedSampler(name, arg string) (Sampler, error) {
+	var root Sampler
+
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case AlwaysSampler:
+		root = NewAlwaysSampler()
+	case NeverSampler:
+		root = NewNeverSampler()
+	case ProbabilisticSampler:
+		rate, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+		if err != nil || rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("invalid sampling rate %q, expected a number between 0 and 1", arg)
+		}
+
+		root = NewProbabilisticSampler(rate)
+	case RateLimitingSampler:
+		limit, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid rate limit %q, expected a non-negative number", arg)
+		}
+
+		root = NewRateLimitingSampler(limit)
+	default:
+		return nil, fmt.Errorf("unknown sampler type %q", name)
+	}
+
+	return NewParentBasedSampler(root), nil
+}
+
+// NewAlwaysSampler returns a sampler that samples every trace
+func NewAlwaysSampler() Sampler {
+	return SamplerFunc(func(SamplingParameters) bool { return true })
+}
+
+// NewNeverSampler returns a sampler that drops every trace
+func NewNeverSampler() Sampler {
+	return SamplerFunc(func(SamplingParameters) bool { return false })
+}
+
+type probabilisticSampler struct {
+	boundary uint64
+}
+
+// NewProbabilisticSampler returns a sampler that samples the given fraction of traces. The decision
+// is derived from the trace ID, so that all services using the same rate come to the same conclusion
+// for a given trace. Rates greater or equal to 1 sample every trace, while rates less or equal to 0
+// drop every trace.
+func NewProbabilisticSampler(rate float64) Sampler {
+	switch {
+	case rate >= 1:
+		return NewAlwaysSampler()
+	case rate <= 0:
+		return NewNeverSampler()
+	}
+
+	return probabilisticSampler{
+		boundary: uint64(rate * math.MaxInt64),
+	}
+}
+
+// ShouldSample returns true if the lower 63 bits of the trace ID fall below the sampling boundary
+func (s probabilisticSampler) ShouldSample(p SamplingParameters) bool {
+	return uint64(p.TraceID)&math.MaxInt64 < s.boundary
+}
+
+type rateLimitingSampler struct {
+	mu         sync.Mutex
+	maxBalance float64
+	perSecond  float64
+	balance    float64
+	lastTick   time.Time
+
+	now func() time.Time
+}
+
+// NewRateLimitingSampler returns a sampler that samples up to the given number of traces per second
+// using a token bucket algorithm. A burst of up to max(1, perSecond) traces is allowed.
+func NewRateLimitingSampler(perSecond float64) Sampler {
+	if perSecond <= 0 {
+		return NewNeverSampler()
+	}
+
+	return newRateLimitingSampler(perSecond, time.Now)
+}
+
+func newRateLimitingSampler(perSecond float64, now func() time.Time) *rateLimitingSampler {
+	maxBalance := math.Max(1, perSecond)
+
+	return &rateLimitingSampler{
+		maxBalance: maxBalance,
+		perSecond:  perSecond,
+		balance:    maxBalance,
+		lastTick:   now(),
+		now:        now,
+	}
+}
+
+// ShouldSample returns true if there is enough credit left to sample one more trace
+func (s *rateLimitingSampler) ShouldSample(SamplingParameters) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.balance = math.Min(s.maxBalance, s.balance+now.Sub(s.lastTick).Seconds()*s.perSecond)
+	s.lastTick = now
+
+	if s.balance < 1 {
+		return false
+	}
+
+	s.balance--
+
+	return true
+}
+
+type parentBasedSampler struct {
+	root Sampler
+}
+
+// NewParentBasedSampler returns a sampler that respects the sampling decision made upstream. If the
+// span has a parent, it is sampled only if the parent has been sampled. The decision for root spans
+// is delegated to the root sampler.
+//
+// The parent is considered to be sampled if its context is not suppressed. For parent contexts
+// coming from a 3rd party tracer that only provided W3C trace context, the traceparent sampled
+// flag is used instead.
+func NewParentBasedSampler(root Sampler) Sampler {
+	return parentBasedSampler{
+		root: root,
+	}
+}
+
+// ShouldSample implements instana.Sampler
+func (s parentBasedSampler) ShouldSample(p SamplingParameters) bool {
+	if p.Parent == nil {
+		return s.root.ShouldSample(p)
+	}
+
+	return parentSampled(*p.Parent)
+}
+
+// parentSampled returns the sampling decision made upstream for the parent span context
+func parentSampled(parent SpanContext) bool {
+	if parent.Suppressed {
+		return false
+	}
+
+	if parent.TraceIDHi == 0 && parent.TraceID == 0 && parent.SpanID == 0 && !parent.W3CContext.IsZero() {
+		return parent.W3CContext.Parent().Flags.Sampled
+	}
+
+	return true
+}
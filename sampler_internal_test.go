@@ -0,0 +1,45 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitingSampler_ShouldSample(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s := newRateLimitingSampler(2, func() time.Time { return now })
+
+	// initial burst
+	assert.True(t, s.ShouldSample(SamplingParameters{}))
+	assert.True(t, s.ShouldSample(SamplingParameters{}))
+	assert.False(t, s.ShouldSample(SamplingParameters{}))
+
+	// half a second later there is enough credit for one more trace
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, s.ShouldSample(SamplingParameters{}))
+	assert.False(t, s.ShouldSample(SamplingParameters{}))
+
+	// the balance never exceeds the burst size
+	now = now.Add(time.Minute)
+	assert.True(t, s.ShouldSample(SamplingParameters{}))
+	assert.True(t, s.ShouldSample(SamplingParameters{}))
+	assert.False(t, s.ShouldSample(SamplingParameters{}))
+}
+
+func TestRateLimitingSampler_ShouldSample_LessThanOnePerSecond(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s := newRateLimitingSampler(0.5, func() time.Time { return now })
+
+	assert.True(t, s.ShouldSample(SamplingParameters{}))
+	assert.False(t, s.ShouldSample(SamplingParameters{}))
+
+	now = now.Add(time.Second)
+	assert.False(t, s.ShouldSample(SamplingParameters{}))
+
+	now = now.Add(time.Second)
+	assert.True(t, s.ShouldSample(SamplingParameters{}))
+}
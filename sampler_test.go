@@ -0,0 +1,235 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"net/http"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/instana/go-sensor/w3ctrace"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProbabilisticSampler(t *testing.T) {
+	examples := map[string]struct {
+		Rate     float64
+		TraceID  int64
+		Expected bool
+	}{
+		"rate=1":                  {Rate: 1, TraceID: 0x7fffffffffffffff, Expected: true},
+		"rate=0":                  {Rate: 0, TraceID: 0, Expected: false},
+		"rate=0.5, below":         {Rate: 0.5, TraceID: 0x1fffffffffffffff, Expected: true},
+		"rate=0.5, above":         {Rate: 0.5, TraceID: 0x5fffffffffffffff, Expected: false},
+		"rate=0.5, negative, low": {Rate: 0.5, TraceID: -0x7fffffffffffffff, Expected: true},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			s := instana.NewProbabilisticSampler(example.Rate)
+			assert.Equal(t, example.Expected, s.ShouldSample(instana.SamplingParameters{
+				TraceID: example.TraceID,
+			}))
+		})
+	}
+}
+
+func TestNewRateLimitingSampler(t *testing.T) {
+	s := instana.NewRateLimitingSampler(2)
+
+	assert.True(t, s.ShouldSample(instana.SamplingParameters{}))
+	assert.True(t, s.ShouldSample(instana.SamplingParameters{}))
+	assert.False(t, s.ShouldSample(instana.SamplingParameters{}))
+
+	assert.False(t, instana.NewRateLimitingSampler(0).ShouldSample(instana.SamplingParameters{}))
+}
+
+func TestNewParentBasedSampler(t *testing.T) {
+	examples := map[string]struct {
+		Root     instana.Sampler
+		Parent   *instana.SpanContext
+		Expected bool
+	}{
+		"root span, sampled": {
+			Root:     instana.NewAlwaysSampler(),
+			Expected: true,
+		},
+		"root span, not sampled": {
+			Root:     instana.NewNeverSampler(),
+			Expected: false,
+		},
+		"sampled parent": {
+			Root:     instana.NewNeverSampler(),
+			Parent:   &instana.SpanContext{TraceID: 1, SpanID: 2},
+			Expected: true,
+		},
+		"suppressed parent": {
+			Root:     instana.NewAlwaysSampler(),
+			Parent:   &instana.SpanContext{TraceID: 1, SpanID: 2, Suppressed: true},
+			Expected: false,
+		},
+		"w3c parent, sampled": {
+			Root: instana.NewNeverSampler(),
+			Parent: &instana.SpanContext{
+				W3CContext: w3ctrace.Context{RawParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+			},
+			Expected: true,
+		},
+		"w3c parent, not sampled": {
+			Root: instana.NewAlwaysSampler(),
+			Parent: &instana.SpanContext{
+				W3CContext: w3ctrace.Context{RawParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"},
+			},
+			Expected: false,
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			s := instana.NewParentBasedSampler(example.Root)
+			assert.Equal(t, example.Expected, s.ShouldSample(instana.SamplingParameters{
+				Parent: example.Parent,
+			}))
+		})
+	}
+}
+
+func TestNamedSampler(t *testing.T) {
+	examples := map[string]struct {
+		Name, Arg string
+		Expected  bool
+	}{
+		"always":        {Name: "always", Expected: true},
+		"never":         {Name: "never", Expected: false},
+		"probabilistic": {Name: "Probabilistic", Arg: "1", Expected: true},
+		"rate-limiting": {Name: "rate-limiting", Arg: " 10 ", Expected: true},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			s, err := instana.NamedSampler(example.Name, example.Arg)
+			require.NoError(t, err)
+
+			assert.Equal(t, example.Expected, s.ShouldSample(instana.SamplingParameters{TraceID: 1}))
+		})
+	}
+}
+
+func TestNamedSampler_Error(t *testing.T) {
+	examples := map[string]struct {
+		Name, Arg string
+	}{
+		"unknown sampler":          {Name: "random"},
+		"missing rate":             {Name: "probabilistic"},
+		"rate out of range":        {Name: "probabilistic", Arg: "1.5"},
+		"malformed rate limit":     {Name: "rate-limiting", Arg: "ten"},
+		"negative rate limit":      {Name: "rate-limiting", Arg: "-1"},
+		"negative sampling rate":   {Name: "probabilistic", Arg: "-0.1"},
+		"non-numeric sample rate":  {Name: "probabilistic", Arg: "half"},
+		"empty rate-limiting args": {Name: "rate-limiting"},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			_, err := instana.NamedSampler(example.Name, example.Arg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTracer_StartSpan_Sampler(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	tracer := instana.NewTracerWithEverything(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Tracer: instana.TracerOptions{
+			Sampler: instana.NewParentBasedSampler(instana.SamplerFunc(func(p instana.SamplingParameters) bool {
+				return p.Operation == "sampled"
+			})),
+		},
+	}, recorder)
+	defer instana.ShutdownSensor()
+
+	t.Run("sampled", func(t *testing.T) {
+		sp := tracer.StartSpan("sampled")
+		tracer.StartSpan("child", ot.ChildOf(sp.Context())).Finish()
+		sp.Finish()
+
+		sc := sp.Context().(instana.SpanContext)
+		assert.True(t, sc.Sampled)
+		assert.False(t, sc.Suppressed)
+
+		assert.Len(t, recorder.GetQueuedSpans(), 2)
+	})
+
+	t.Run("not sampled", func(t *testing.T) {
+		sp := tracer.StartSpan("dropped")
+		child := tracer.StartSpan("child", ot.ChildOf(sp.Context()))
+		child.Finish()
+		sp.Finish()
+
+		sc := sp.Context().(instana.SpanContext)
+		assert.False(t, sc.Sampled)
+		assert.True(t, sc.Suppressed)
+		assert.False(t, child.Context().(instana.SpanContext).Sampled)
+
+		assert.Empty(t, recorder.GetQueuedSpans())
+
+		h := http.Header{}
+		require.NoError(t, tracer.Inject(sp.Context(), ot.HTTPHeaders, ot.HTTPHeadersCarrier(h)))
+
+		assert.Equal(t, "0", h.Get(instana.FieldL))
+		assert.Empty(t, h.Get(instana.FieldT))
+		assert.Empty(t, h.Get(instana.FieldS))
+
+		trCtx, err := w3ctrace.Extract(h)
+		require.NoError(t, err)
+		assert.False(t, trCtx.Parent().Flags.Sampled)
+	})
+}
+
+func TestTracer_StartSpan_SamplerRootSpansOnly(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	tracer := instana.NewTracerWithEverything(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Tracer: instana.TracerOptions{
+			Sampler: instana.SamplerFunc(func(p instana.SamplingParameters) bool {
+				return p.Operation == "sampled"
+			}),
+		},
+	}, recorder)
+	defer instana.ShutdownSensor()
+
+	t.Run("sampled", func(t *testing.T) {
+		sp := tracer.StartSpan("sampled")
+		child := tracer.StartSpan("child", ot.ChildOf(sp.Context()))
+		child.Finish()
+		sp.Finish()
+
+		assert.True(t, child.Context().(instana.SpanContext).Sampled)
+		assert.Len(t, recorder.GetQueuedSpans(), 2)
+	})
+
+	t.Run("not sampled", func(t *testing.T) {
+		sp := tracer.StartSpan("dropped")
+		child := tracer.StartSpan("sampled", ot.ChildOf(sp.Context()))
+		child.Finish()
+		sp.Finish()
+
+		assert.False(t, child.Context().(instana.SpanContext).Sampled)
+		assert.Empty(t, recorder.GetQueuedSpans())
+	})
+}
+
+func TestTracer_StartSpan_DefaultSampling(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	tracer := instana.NewTracerWithEverything(&instana.Options{AgentClient: alwaysReadyClient{}}, recorder)
+	defer instana.ShutdownSensor()
+
+	sp := tracer.StartSpan("test")
+	sp.Finish()
+
+	assert.True(t, sp.Context().(instana.SpanContext).Sampled)
+	assert.Len(t, recorder.GetQueuedSpans(), 1)
+}
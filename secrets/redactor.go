@@ -0,0 +1,194 @@
+// (c) Copyright IBM Corp. 2026
+
+package secrets
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// Redacted is the value sensitive data is replaced with
+const Redacted = "<redacted>"
+
+// Names of the built-in value patterns
+const (
+	CreditCardPattern  = "credit-card"
+	IBANPattern        = "iban"
+	JWTPattern         = "jwt"
+	BearerTokenPattern = "bearer-token"
+	EmailPattern       = "email"
+)
+
+// ValuePattern detects sensitive data within a string value. If the regular expression contains a capturing
+// group, only the captured part of the match is redacted.
+type ValuePattern struct {
+	Name string
+
+	re       *regexp.Regexp
+	validate func(match string) bool
+}
+
+// NewRegexpValuePattern returns a value pattern that redacts all matches of a regular expression
+func NewRegexpValuePattern(re *regexp.Regexp) ValuePattern {
+	return ValuePattern{
+		Name: re.String(),
+		re:   re,
+	}
+}
+
+var builtinValuePatterns = map[string]ValuePattern{
+	CreditCardPattern: {
+		Name:     CreditCardPattern,
+		re:       regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		validate: isValidCardNumber,
+	},
+	IBANPattern: {
+		Name:     IBANPattern,
+		re:       regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]){11,30}\b`),
+		validate: isValidIBAN,
+	},
+	JWTPattern: {
+		Name: JWTPattern,
+		re:   regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+	},
+	BearerTokenPattern: {
+		Name: BearerTokenPattern,
+		re:   regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`),
+	},
+	EmailPattern: {
+		Name: EmailPattern,
+		re:   regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+	},
+}
+
+// NamedValuePattern returns one of the built-in value patterns:
+//
+// * `credit-card` matches payment card numbers that pass the Luhn checksum validation
+// * `iban` matches international bank account numbers with a valid check digits
+// * `jwt` matches JSON Web Tokens
+// * `bearer-token` matches the token part of a bearer authorization value
+// * `email` matches email addresses
+func NamedValuePattern(name string) (ValuePattern, error) {
+	p, ok := builtinValuePatterns[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return ValuePattern{}, fmt.Errorf("unknown secret value pattern %q", name)
+	}
+
+	return p, nil
+}
+
+// ValueRedactor replaces sensitive data found in string values, such as URLs, database
+// statements and log messages, with "<redacted>"
+type ValueRedactor struct {
+	patterns []ValuePattern
+}
+
+// NewValueRedactor returns a ValueRedactor for a list of value patterns
+func NewValueRedactor(patterns ...ValuePattern) *ValueRedactor {
+	return &ValueRedactor{patterns: patterns}
+}
+
+// Redact returns a copy of s with all matches of the value patterns replaced with "<redacted>"
+func (r *ValueRedactor) Redact(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+
+	for _, p := range r.patterns {
+		s = p.redact(s)
+	}
+
+	return s
+}
+
+func (p ValuePattern) redact(s string) string {
+	matches := p.re.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	var (
+		buf  strings.Builder
+		last int
+	)
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if p.validate != nil && !p.validate(s[start:end]) {
+			continue
+		}
+
+		// redact only the captured part of the match, if any
+		if len(m) >= 4 && m[2] >= 0 {
+			start, end = m[2], m[3]
+		}
+
+		buf.WriteString(s[last:start])
+		buf.WriteString(Redacted)
+		last = end
+	}
+
+	if last == 0 {
+		return s
+	}
+
+	buf.WriteString(s[last:])
+
+	return buf.String()
+}
+
+// isValidCardNumber verifies the card number checksum using the Luhn algorithm
+func isValidCardNumber(s string) bool {
+	var (
+		sum, n int
+		double bool
+	)
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+		double = !double
+		n++
+	}
+
+	return n >= 13 && n <= 19 && sum%10 == 0
+}
+
+// isValidIBAN verifies the IBAN check digits as defined by ISO 13616
+func isValidIBAN(s string) bool {
+	s = strings.ReplaceAll(s, " ", "")
+	if len(s) < 15 || len(s) > 34 {
+		return false
+	}
+
+	var digits strings.Builder
+	for _, c := range s[4:] + s[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteRune(c)
+		case c >= 'A' && c <= 'Z':
+			fmt.Fprintf(&digits, "%d", c-'A'+10)
+		default:
+			return false
+		}
+	}
+
+	n, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+
+	return new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
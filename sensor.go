@@ -75,6 +75,7 @@ type sensorS struct {
 	meter       *meterS
 	logger      LeveledLogger
 	options     *Options
+	optionsMu   sync.RWMutex
 	serviceName string
 	binaryName  string
 
@@ -131,7 +132,18 @@ func newSensor(options *Options) *sensorS {
 
 	s.meter = newMeter(s.logger)
 	if agent == nil {
-		agent = newAgent(s.serviceOrBinaryName(), s.options.AgentHost, s.options.AgentPort, s.logger)
+		hostAgent := newAgent(s.serviceOrBinaryName(), s.options.AgentHost, s.options.AgentPort, s.logger)
+
+		if options.Spool.Dir != "" {
+			sp, err := newSpool(options.Spool, s.logger)
+			if err != nil {
+				s.logger.Warn("failed to initialize the spool, the data that could not be sent to the agent will be dropped: ", err)
+			} else {
+				hostAgent.setSpool(sp)
+			}
+		}
+
+		agent = hostAgent
 	}
 
 	s.setAgent(agent)
@@ -142,6 +154,10 @@ func newSensor(options *Options) *sensorS {
 		s.meter.Run(s.options.Metrics.getTransmissionInterval())
 	}
 
+	if configPath, ok := lookupValidatedEnv("INSTANA_CONFIG_PATH"); ok && options.ConfigRefreshInterval > 0 {
+		go s.watchConfigFile(configPath, options.ConfigRefreshInterval)
+	}
+
 	return s
 }
 
@@ -324,9 +340,27 @@ func Flush(ctx context.Context) error {
 // Deprecated: Use [ShutdownCollector] instead.
 func ShutdownSensor() {
 	muSensor.Lock()
-	defer muSensor.Unlock()
-	if sensor != nil {
-		sensor = nil
+	s := sensor
+	sensor = nil
+	muSensor.Unlock()
+
+	// the sensor resources are released without holding the lock, since the background
+	// goroutines might need to acquire it before they exit
+	s.close()
+}
+
+// close releases the resources held by the sensor, such as the open spool segment
+func (r *sensorS) close() {
+	if r == nil {
+		return
+	}
+
+	if agent, ok := r.Agent().(*agentS); ok {
+		if sp := agent.getSpool(); sp != nil {
+			if err := sp.Close(); err != nil {
+				r.logger.Warn("failed to close the spool: ", err)
+			}
+		}
 	}
 }
 
@@ -344,9 +378,19 @@ func ShutdownCollector() {
 
 	ShutdownSensor()
 	muc.Lock()
-	defer muc.Unlock()
+	prev := c
 	c = newNoopCollector()
 	once = sync.Once{}
+	muc.Unlock()
+
+	// stop the background flusher of the recorder, so that it does not outlive the collector
+	if col, ok := prev.(*Collector); ok {
+		if tracer, ok := col.t.(*tracerS); ok {
+			if rec, ok := tracer.recorder.(*Recorder); ok {
+				rec.Close()
+			}
+		}
+	}
 }
 
 func newServerlessAgent(serviceName, agentEndpoint, agentKey string,
@@ -32,6 +32,10 @@ type spanS struct {
 	Start       time.Time
 	Duration    time.Duration
 	Correlation EUMCorrelationData
+	Links       []SpanReference
+	Stack       []StackFrame
+	Errors      []ErrorEvent
+	FollowsFrom bool
 	Tags        ot.Tags
 	Logs        []ot.LogRecord
 	ErrorCount  int
@@ -40,6 +44,8 @@ type spanS struct {
 	mu     sync.Mutex
 
 	context SpanContext
+	// doc is the span document that has been passed through the span processors once the span is finished
+	doc *Span
 }
 
 func (r *spanS) BaggageItem(key string) string {
@@ -86,6 +92,17 @@ func (r *spanS) FinishWithOptions(opts ot.FinishOptions) {
 
 	r.Duration = duration
 	if r.sendSpanToAgent() {
+		tracerOpts := r.tracer.Options()
+		r.normalizeStatements(tracerOpts.NormalizeStatements)
+		r.redactTagValues(tracerOpts.ValueRedactor)
+		r.redactErrorEvents(tracerOpts.ValueRedactor)
+
+		doc := newSpan(r)
+		if !processSpanDocument(&doc) {
+			return
+		}
+		r.doc = &doc
+
 		if sensor.Agent().Ready() {
 			r.tracer.recorder.RecordSpan(r)
 		} else {
@@ -106,6 +123,11 @@ func (r *spanS) sendSpanToAgent() bool {
 		return false
 	}
 
+	// spans of a trace that has not been sampled shouldn't be forwarded
+	if !r.context.Sampled {
+		return false
+	}
+
 	if !isRootExitSpan(r.Tags[string(ext.SpanKind)], r.context.ParentID == 0) {
 		// if the span is an entry span, intermediate span, exit span with a parent
 		// it should be forwarded to the agent
@@ -151,11 +173,20 @@ func (r *spanS) LogEventWithPayload(event string, payload interface{}) {
 }
 
 func (r *spanS) LogFields(fields ...otlog.Field) {
+	r.logFields(nil, fields...)
+}
+
+// logFields records the log fields to the span. The error-level fields are attached to the span as error events
+// along with the provided call stack, which is only captured when an error is recorded explicitly with
+// RecordError() or RecordPanic().
+func (r *spanS) logFields(stack []StackFrame, fields ...otlog.Field) {
+	now := time.Now()
 
 	for _, v := range fields {
 		// If this tag indicates an error, increase the error count
 		if openTracingLogFieldLevel(v) == logger.ErrorLevel {
 			r.ErrorCount++
+			r.addErrorEvent(newErrorEvent(v.Value(), stack, now))
 		}
 	}
 
@@ -171,12 +202,46 @@ func (r *spanS) LogFields(fields ...otlog.Field) {
 	defer r.mu.Unlock()
 
 	if lr.Timestamp.IsZero() {
-		lr.Timestamp = time.Now()
+		lr.Timestamp = now
 	}
 
 	r.appendLog(lr)
 }
 
+// addErrorEvent attaches the error event to the span. If the same error has already been recorded, the number of
+// its occurrences is incremented instead. The events exceeding the maxErrorEventsPerSpan limit are dropped.
+func (r *spanS) addErrorEvent(ev ErrorEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.Errors {
+		if r.Errors[i].sameAs(ev) {
+			r.Errors[i].Count++
+			return
+		}
+	}
+
+	if len(r.Errors) < maxErrorEventsPerSpan {
+		r.Errors = append(r.Errors, ev)
+	}
+}
+
+// recordError logs the error and attaches the call stack to the span, unless it already has one
+func (r *spanS) recordError(err error, stack []StackFrame) {
+	r.logFields(stack, otlog.Error(err))
+	r.attachStack(stack)
+}
+
+// attachStack attaches the call stack to the span, unless it already has one
+func (r *spanS) attachStack(stack []StackFrame) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.Stack) == 0 {
+		r.Stack = stack
+	}
+}
+
 func (r *spanS) LogKV(keyValues ...interface{}) {
 	fields, err := otlog.InterleavedKVToFields(keyValues...)
 	if err != nil {
@@ -215,6 +280,11 @@ func (r *spanS) SetTag(key string, value interface{}) ot.Span {
 		return r
 	}
 
+	if links, ok := value.([]SpanReference); ok && key == linksTag {
+		r.Links = uniqueSpanReferences(append(r.Links, links...))
+		return r
+	}
+
 	r.Tags[key] = value
 
 	return r
@@ -314,11 +384,12 @@ func (c spanCategory) disabled() bool {
 
 	// Check if sensor or options are nil
 	muSensor.RLock()
-	defer muSensor.RUnlock()
+	s := sensor
+	muSensor.RUnlock()
 
-	if sensor == nil || sensor.options.Tracer.DisableSpans == nil {
+	if s == nil || s.options == nil {
 		return false
 	}
 
-	return sensor.options.Tracer.DisableSpans[c.string()]
+	return s.tracerOptions().DisableSpans[c.string()]
 }
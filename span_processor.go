@@ -0,0 +1,133 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	ot "github.com/opentracing/opentracing-go"
+)
+
+const redactedTagValue = "<redacted>"
+
+// SpanProcessor is a hook into the span lifecycle that allows to enforce policies, such as adding resource tags,
+// dropping or redacting spans, in a single place instead of every instrumented call site. Span processors are
+// configured via Options.SpanProcessors and are called in the order they are listed.
+type SpanProcessor interface {
+	// OnStart is called synchronously when a span is started. The processor may set additional tags on the span.
+	OnStart(span ot.Span)
+	// OnEnd is called with the span document produced for a finished span before it is queued for delivery.
+	// The processor may modify the document. Returning false drops the span, in which case the processors
+	// that follow are not called.
+	OnEnd(span *Span) bool
+}
+
+// Operation returns the operation name of a span. For SDK spans, this is the name the span has been
+// started with, while for the registered span types it matches the span type, e.g. "g.http".
+func (sp *Span) Operation() string {
+	if data, ok := sp.Data.(SDKSpanData); ok {
+		return data.Tags.Name
+	}
+
+	return sp.Name
+}
+
+// CustomTags returns the user-defined tags of a span. The returned map can be modified to change the tags
+// that are sent to the agent. The return value is nil if a span does not have any custom tags.
+func (sp *Span) CustomTags() map[string]interface{} {
+	switch data := sp.Data.(type) {
+	case SDKSpanData:
+		tags, _ := data.Tags.Custom["tags"].(ot.Tags)
+		return tags
+	case interface{ customSpanData() *CustomSpanData }:
+		if custom := data.customSpanData(); custom != nil {
+			return custom.Tags
+		}
+	}
+
+	return nil
+}
+
+// NewResourceTagsProcessor returns a span processor that sets provided tags on every started span
+func NewResourceTagsProcessor(tags ot.Tags) SpanProcessor {
+	return resourceTagsProcessor{tags: cloneTags(tags)}
+}
+
+type resourceTagsProcessor struct {
+	tags ot.Tags
+}
+
+func (p resourceTagsProcessor) OnStart(span ot.Span) {
+	for k, v := range p.tags {
+		span.SetTag(k, v)
+	}
+}
+
+func (resourceTagsProcessor) OnEnd(*Span) bool { return true }
+
+// NewDropSpansProcessor returns a span processor that drops the spans with provided operation names
+func NewDropSpansProcessor(operations ...string) SpanProcessor {
+	p := dropSpansProcessor{operations: make(map[string]struct{}, len(operations))}
+	for _, op := range operations {
+		p.operations[op] = struct{}{}
+	}
+
+	return p
+}
+
+type dropSpansProcessor struct {
+	operations map[string]struct{}
+}
+
+func (dropSpansProcessor) OnStart(ot.Span) {}
+
+func (p dropSpansProcessor) OnEnd(span *Span) bool {
+	_, drop := p.operations[span.Operation()]
+	return !drop
+}
+
+// NewRedactTagsProcessor returns a span processor that replaces the values of custom span tags, which names
+// are matched by m, with "<redacted>".
+//
+// Note: the values of tags that are known to the registered span types, such as http.url, are not
+// affected by this processor.
+func NewRedactTagsProcessor(m Matcher) SpanProcessor {
+	return redactTagsProcessor{matcher: m}
+}
+
+type redactTagsProcessor struct {
+	matcher Matcher
+}
+
+func (redactTagsProcessor) OnStart(ot.Span) {}
+
+func (p redactTagsProcessor) OnEnd(span *Span) bool {
+	tags := span.CustomTags()
+	for k := range tags {
+		if p.matcher.Match(k) {
+			tags[k] = redactedTagValue
+		}
+	}
+
+	return true
+}
+
+// spanProcessors returns the span processors configured for the sensor
+func spanProcessors() []SpanProcessor {
+	s, err := getSensor()
+	if err != nil || s.options == nil {
+		return nil
+	}
+
+	return s.options.SpanProcessors
+}
+
+// processSpanDocument passes the span document through the configured span processors and reports
+// whether it should be sent to the agent
+func processSpanDocument(sp *Span) bool {
+	for _, p := range spanProcessors() {
+		if !p.OnEnd(sp) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,80 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"testing"
+
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type droppingSpanProcessor struct{}
+
+func (droppingSpanProcessor) OnStart(ot.Span)  {}
+func (droppingSpanProcessor) OnEnd(*Span) bool { return false }
+
+func TestSpanProcessors_ConcurrentShutdown(t *testing.T) {
+	newOptions := func() *Options {
+		return &Options{
+			Service:        "go-sensor-test",
+			AgentClient:    alwaysReadyClient{},
+			Recorder:       NewTestRecorder(),
+			SpanProcessors: []SpanProcessor{droppingSpanProcessor{}},
+		}
+	}
+
+	InitCollector(newOptions())
+	defer ShutdownCollector()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 1000; i++ {
+			processSpanDocument(&Span{})
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		ShutdownCollector()
+		InitCollector(newOptions())
+	}
+
+	<-done
+
+	assert.False(t, processSpanDocument(&Span{}))
+}
+
+type collectingRecorder struct {
+	spans []*spanS
+}
+
+func (r *collectingRecorder) RecordSpan(span *spanS)      { r.spans = append(r.spans, span) }
+func (r *collectingRecorder) Flush(context.Context) error { return nil }
+
+func TestSpanProcessors_CustomRecorder(t *testing.T) {
+	m, err := NamedMatcher(EqualsMatcher, []string{"password"})
+	require.NoError(t, err)
+
+	recorder := &collectingRecorder{}
+	c := InitCollector(&Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		SpanProcessors: []SpanProcessor{
+			NewDropSpansProcessor("dropped"),
+			NewRedactTagsProcessor(m),
+		},
+	})
+	defer ShutdownCollector()
+
+	c.StartSpan("dropped").Finish()
+	c.StartSpan("kept", ot.Tags{"password": "secret"}).Finish()
+
+	require.Len(t, recorder.spans, 1)
+	require.NotNil(t, recorder.spans[0].doc)
+	assert.Equal(t, "kept", recorder.spans[0].doc.Operation())
+	assert.Equal(t, redactedTagValue, recorder.spans[0].doc.CustomTags()["password"])
+}
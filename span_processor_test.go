@@ -0,0 +1,99 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSpanProcessor struct {
+	started int
+	ended   []string
+}
+
+func (p *recordingSpanProcessor) OnStart(span ot.Span) {
+	p.started++
+}
+
+func (p *recordingSpanProcessor) OnEnd(span *instana.Span) bool {
+	p.ended = append(p.ended, span.Operation())
+	return true
+}
+
+func TestSpanProcessors(t *testing.T) {
+	m, err := instana.NamedMatcher(instana.EqualsIgnoreCaseMatcher, []string{"user.email"})
+	require.NoError(t, err)
+
+	rp := &recordingSpanProcessor{}
+
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		SpanProcessors: []instana.SpanProcessor{
+			instana.NewResourceTagsProcessor(ot.Tags{"team": "checkout"}),
+			instana.NewDropSpansProcessor("healthcheck"),
+			instana.NewRedactTagsProcessor(m),
+			rp,
+		},
+	})
+	defer instana.ShutdownCollector()
+
+	sp := c.StartSpan("checkout")
+	sp.SetTag("user.email", "jdoe@example.com")
+	sp.Finish()
+
+	c.StartSpan("healthcheck").Finish()
+
+	httpSp := c.StartSpan("g.http", ext.SpanKindRPCServer)
+	httpSp.SetTag("user.email", "jdoe@example.com")
+	httpSp.SetTag(string(ext.HTTPMethod), "GET")
+	httpSp.Finish()
+
+	assert.Equal(t, 3, rp.started)
+	assert.Equal(t, []string{"checkout", "g.http"}, rp.ended, "dropped spans are not passed to the following processors")
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, map[string]interface{}{
+		"team":       "checkout",
+		"user.email": "<redacted>",
+	}, spans[0].CustomTags())
+
+	assert.Equal(t, map[string]interface{}{
+		"team":       "checkout",
+		"user.email": "<redacted>",
+	}, spans[1].CustomTags())
+
+	httpData, ok := spans[1].Data.(instana.HTTPSpanData)
+	require.True(t, ok)
+	assert.Equal(t, "GET", httpData.Tags.Method)
+}
+
+func TestSpan_Operation(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	c.StartSpan("sdk-span").Finish()
+	c.StartSpan("g.http").Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "sdk-span", spans[0].Operation())
+	assert.Nil(t, spans[0].CustomTags())
+
+	assert.Equal(t, "g.http", spans[1].Operation())
+	assert.Nil(t, spans[1].CustomTags())
+}
@@ -0,0 +1,452 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default spool configuration
+const (
+	DefaultSpoolMaxSize     = 64 * 1024 * 1024
+	DefaultSpoolMaxAge      = time.Hour
+	DefaultSpoolSegmentSize = 4 * 1024 * 1024
+)
+
+const (
+	spoolSegmentExt = ".spool"
+	// record header: payload length (4 bytes), CRC32 checksum (4 bytes), kind (1 byte), timestamp (8 bytes)
+	spoolRecordHeaderLen = 17
+)
+
+// SpoolOptions configures the disk-backed spool that keeps the data that could not be sent to the host
+// agent. The spool is disabled unless Dir is set.
+type SpoolOptions struct {
+	// Dir is the directory to store the spool segment files in. The directory is created if it does not exist.
+	// The spool can also be enabled by setting the INSTANA_SPOOL_DIR env var, which takes precedence over
+	// the in-code configuration.
+	Dir string
+	// MaxSize is the maximum total size of the spool files in bytes. Once this limit is reached, the oldest
+	// segments are removed. If set to 0, instana.DefaultSpoolMaxSize is used.
+	MaxSize int64
+	// MaxAge is the maximum age of a spooled record. Older records are discarded instead of being sent to the agent.
+	// If set to 0, instana.DefaultSpoolMaxAge is used.
+	MaxAge time.Duration
+	// SegmentSize is the size of a segment file in bytes, after which a new segment is started. If set to 0,
+	// instana.DefaultSpoolSegmentSize is used.
+	SegmentSize int64
+}
+
+func (opts *SpoolOptions) setDefaults() {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultSpoolMaxSize
+	}
+
+	if opts.MaxAge <= 0 {
+		opts.MaxAge = DefaultSpoolMaxAge
+	}
+
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = DefaultSpoolSegmentSize
+	}
+
+	if opts.SegmentSize > opts.MaxSize {
+		opts.SegmentSize = opts.MaxSize
+	}
+}
+
+type spoolRecordKind uint8
+
+const (
+	spoolSpans spoolRecordKind = iota + 1
+	spoolProfiles
+	spoolEvent
+)
+
+type spoolRecord struct {
+	Kind      spoolRecordKind
+	Timestamp time.Time
+	Payload   json.RawMessage
+}
+
+var errSpoolRecordCorrupted = errors.New("spool record is corrupted")
+
+// spool is a persistent FIFO queue of records stored in a sequence of append-only segment files.
+// Each record carries a checksum, so that a partially written record left by a crash is detected
+// and discarded along with anything that follows it in the same segment.
+type spool struct {
+	opts SpoolOptions
+
+	// replayMu ensures that only one replay is in progress at a time
+	replayMu sync.Mutex
+
+	mu       sync.Mutex
+	segments []spoolSegment
+	active   *os.File
+	nextSeq  uint64
+
+	now    func() time.Time
+	logger LeveledLogger
+}
+
+type spoolSegment struct {
+	Seq  uint64
+	Size int64
+}
+
+func newSpool(opts SpoolOptions, logger LeveledLogger) (*spool, error) {
+	opts.setDefaults()
+
+	if err := os.MkdirAll(opts.Dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(opts.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	sp := &spool{
+		opts:    opts,
+		now:     time.Now,
+		logger:  logger,
+		nextSeq: 1,
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, spoolSegmentExt) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, spoolSegmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		sp.segments = append(sp.segments, spoolSegment{Seq: seq, Size: info.Size()})
+		if seq >= sp.nextSeq {
+			sp.nextSeq = seq + 1
+		}
+	}
+
+	sort.Slice(sp.segments, func(i, j int) bool { return sp.segments[i].Seq < sp.segments[j].Seq })
+
+	return sp, nil
+}
+
+// Append encodes the payload and writes it to the active segment. The write is synced to disk before
+// the method returns.
+func (sp *spool) Append(kind spoolRecordKind, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spool record: %w", err)
+	}
+
+	rec := encodeSpoolRecord(spoolRecord{
+		Kind:      kind,
+		Timestamp: sp.now(),
+		Payload:   data,
+	})
+
+	if int64(len(rec)) > sp.opts.MaxSize {
+		return fmt.Errorf("spool record size %d exceeds the spool size limit", len(rec))
+	}
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	if sp.active == nil || sp.segments[len(sp.segments)-1].Size+int64(len(rec)) > sp.opts.SegmentSize {
+		if err := sp.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := sp.active.Write(rec); err != nil {
+		return fmt.Errorf("failed to write spool record: %w", err)
+	}
+
+	if err := sp.active.Sync(); err != nil {
+		return fmt.Errorf("failed to sync spool segment: %w", err)
+	}
+
+	sp.segments[len(sp.segments)-1].Size += int64(len(rec))
+	sp.enforceSizeLimit()
+
+	return nil
+}
+
+// Replay reads the records in the order they were written and passes them to the send function. Records
+// older than the configured max age are discarded. Fully processed segments are removed. If send returns an
+// error, the replay is stopped and the remaining records are kept in the spool for the next attempt.
+//
+// The spool is not locked while the records are being sent, so that new records can be appended meanwhile.
+// Only the segments that existed before the replay has started are replayed.
+func (sp *spool) Replay(send func(spoolRecord) error) error {
+	sp.replayMu.Lock()
+	defer sp.replayMu.Unlock()
+
+	sp.mu.Lock()
+	// new records are written to a new segment while the existing ones are being replayed
+	sp.closeActive()
+
+	if len(sp.segments) == 0 {
+		sp.mu.Unlock()
+		return nil
+	}
+
+	lastSeq := sp.segments[len(sp.segments)-1].Seq
+	sp.mu.Unlock()
+
+	minTimestamp := sp.now().Add(-sp.opts.MaxAge)
+
+	for {
+		sp.mu.Lock()
+		if len(sp.segments) == 0 || sp.segments[0].Seq > lastSeq {
+			sp.mu.Unlock()
+			return nil
+		}
+
+		seg := sp.segments[0]
+
+		records, err := sp.readSegment(seg)
+		sp.mu.Unlock()
+
+		if err != nil {
+			sp.logger.Warn("failed to read spool segment ", sp.segmentPath(seg.Seq), ", discarding: ", err)
+		}
+
+		for i, rec := range records {
+			if rec.Timestamp.Before(minTimestamp) {
+				continue
+			}
+
+			if err := send(rec); err != nil {
+				sp.mu.Lock()
+				// the segment might have been removed to fit the size limit while the records were being sent
+				if len(sp.segments) > 0 && sp.segments[0].Seq == seg.Seq {
+					if rewriteErr := sp.rewriteSegment(seg, records[i:]); rewriteErr != nil {
+						sp.logger.Warn("failed to update spool segment: ", rewriteErr)
+					}
+				}
+				sp.mu.Unlock()
+
+				return err
+			}
+		}
+
+		sp.mu.Lock()
+		if len(sp.segments) > 0 && sp.segments[0].Seq == seg.Seq {
+			sp.removeOldestSegment()
+		}
+		sp.mu.Unlock()
+	}
+}
+
+// Len returns the number of segments in the spool
+func (sp *spool) Len() int {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	return len(sp.segments)
+}
+
+// Close closes the active segment file
+func (sp *spool) Close() error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	return sp.closeActive()
+}
+
+func (sp *spool) rotate() error {
+	sp.closeActive()
+
+	seq := sp.nextSeq
+
+	f, err := os.OpenFile(sp.segmentPath(seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create spool segment: %w", err)
+	}
+
+	sp.nextSeq++
+	sp.active = f
+	sp.segments = append(sp.segments, spoolSegment{Seq: seq})
+
+	return nil
+}
+
+func (sp *spool) closeActive() error {
+	if sp.active == nil {
+		return nil
+	}
+
+	err := sp.active.Close()
+	sp.active = nil
+
+	return err
+}
+
+// enforceSizeLimit removes the oldest segments until the total spool size fits the limit. The active
+// segment is never removed.
+func (sp *spool) enforceSizeLimit() {
+	var total int64
+	for _, seg := range sp.segments {
+		total += seg.Size
+	}
+
+	for total > sp.opts.MaxSize && len(sp.segments) > 1 {
+		total -= sp.segments[0].Size
+		sp.logger.Warn("spool size limit exceeded, discarding the oldest segment ", sp.segmentPath(sp.segments[0].Seq))
+		sp.removeOldestSegment()
+	}
+}
+
+func (sp *spool) removeOldestSegment() {
+	if err := os.Remove(sp.segmentPath(sp.segments[0].Seq)); err != nil && !os.IsNotExist(err) {
+		sp.logger.Warn("failed to remove spool segment: ", err)
+	}
+
+	sp.segments = sp.segments[1:]
+}
+
+// readSegment returns all valid records from a segment. A corrupted record, i.e. a partial write,
+// stops the reading and is returned along with the records read so far.
+func (sp *spool) readSegment(seg spoolSegment) ([]spoolRecord, error) {
+	f, err := os.Open(sp.segmentPath(seg.Seq))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	var records []spoolRecord
+	for {
+		rec, err := decodeSpoolRecord(r)
+		if err == io.EOF {
+			return records, nil
+		}
+
+		if err != nil {
+			return records, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+// rewriteSegment atomically replaces the segment file with the one that contains only provided records
+func (sp *spool) rewriteSegment(seg spoolSegment, records []spoolRecord) error {
+	tmpPath := sp.segmentPath(seg.Seq) + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	var size int64
+	for _, rec := range records {
+		n, err := f.Write(encodeSpoolRecord(rec))
+		if err != nil {
+			f.Close()
+			os.Remove(tmpPath)
+
+			return err
+		}
+
+		size += int64(n)
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, sp.segmentPath(seg.Seq)); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	sp.segments[0].Size = size
+
+	return nil
+}
+
+func (sp *spool) segmentPath(seq uint64) string {
+	return filepath.Join(sp.opts.Dir, fmt.Sprintf("%020d%s", seq, spoolSegmentExt))
+}
+
+func encodeSpoolRecord(rec spoolRecord) []byte {
+	buf := make([]byte, spoolRecordHeaderLen+len(rec.Payload))
+
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(rec.Payload)))
+	buf[8] = byte(rec.Kind)
+	binary.BigEndian.PutUint64(buf[9:17], uint64(rec.Timestamp.UnixNano()))
+	copy(buf[spoolRecordHeaderLen:], rec.Payload)
+
+	// the checksum covers everything following it
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(buf[8:]))
+
+	return buf
+}
+
+func decodeSpoolRecord(r io.Reader) (spoolRecord, error) {
+	header := make([]byte, spoolRecordHeaderLen)
+	if n, err := io.ReadFull(r, header); err != nil {
+		if err == io.EOF && n == 0 {
+			return spoolRecord{}, io.EOF
+		}
+
+		return spoolRecord{}, errSpoolRecordCorrupted
+	}
+
+	size := binary.BigEndian.Uint32(header[0:4])
+	if size > maxContentLength {
+		return spoolRecord{}, errSpoolRecordCorrupted
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return spoolRecord{}, errSpoolRecordCorrupted
+	}
+
+	crc := crc32.NewIEEE()
+	crc.Write(header[8:])
+	crc.Write(payload)
+
+	if crc.Sum32() != binary.BigEndian.Uint32(header[4:8]) {
+		return spoolRecord{}, errSpoolRecordCorrupted
+	}
+
+	return spoolRecord{
+		Kind:      spoolRecordKind(header[8]),
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(header[9:17]))),
+		Payload:   payload,
+	}, nil
+}
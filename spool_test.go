@@ -0,0 +1,301 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/instana/go-sensor/autoprofile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSpool(t *testing.T, opts SpoolOptions) *spool {
+	t.Helper()
+
+	if opts.Dir == "" {
+		opts.Dir = t.TempDir()
+	}
+
+	sp, err := newSpool(opts, defaultLogger)
+	require.NoError(t, err)
+	t.Cleanup(func() { sp.Close() })
+
+	return sp
+}
+
+func replayAll(t *testing.T, sp *spool) []string {
+	t.Helper()
+
+	var payloads []string
+	require.NoError(t, sp.Replay(func(rec spoolRecord) error {
+		payloads = append(payloads, string(rec.Payload))
+		return nil
+	}))
+
+	return payloads
+}
+
+func TestSpool_AppendReplay(t *testing.T) {
+	sp := newTestSpool(t, SpoolOptions{SegmentSize: 64})
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, sp.Append(spoolSpans, strings.Repeat("x", 10+i)))
+	}
+
+	assert.Greater(t, sp.Len(), 1, "expected the records to be split across several segments")
+
+	assert.Equal(t, []string{
+		`"xxxxxxxxxx"`,
+		`"xxxxxxxxxxx"`,
+		`"xxxxxxxxxxxx"`,
+		`"xxxxxxxxxxxxx"`,
+		`"xxxxxxxxxxxxxx"`,
+	}, replayAll(t, sp))
+
+	assert.Equal(t, 0, sp.Len())
+	assert.Empty(t, replayAll(t, sp))
+}
+
+func TestSpool_Restart(t *testing.T) {
+	dir := t.TempDir()
+
+	sp := newTestSpool(t, SpoolOptions{Dir: dir})
+	require.NoError(t, sp.Append(spoolEvent, "first"))
+	require.NoError(t, sp.Close())
+
+	sp = newTestSpool(t, SpoolOptions{Dir: dir})
+	require.NoError(t, sp.Append(spoolEvent, "second"))
+
+	assert.Equal(t, []string{`"first"`, `"second"`}, replayAll(t, sp))
+}
+
+func TestSpool_CorruptedRecord(t *testing.T) {
+	dir := t.TempDir()
+
+	sp := newTestSpool(t, SpoolOptions{Dir: dir})
+	require.NoError(t, sp.Append(spoolSpans, "complete"))
+	require.NoError(t, sp.Append(spoolSpans, "partial"))
+	require.NoError(t, sp.Close())
+
+	// simulate a crash in the middle of a write
+	path := sp.segmentPath(1)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-3))
+
+	sp = newTestSpool(t, SpoolOptions{Dir: dir})
+	require.NoError(t, sp.Append(spoolSpans, "next"))
+
+	assert.Equal(t, []string{`"complete"`, `"next"`}, replayAll(t, sp))
+}
+
+func TestSpool_Replay_Error(t *testing.T) {
+	sp := newTestSpool(t, SpoolOptions{})
+
+	for _, s := range []string{"a", "b", "c"} {
+		require.NoError(t, sp.Append(spoolSpans, s))
+	}
+
+	var sent []string
+	err := sp.Replay(func(rec spoolRecord) error {
+		if string(rec.Payload) == `"b"` {
+			return errors.New("agent is not available")
+		}
+
+		sent = append(sent, string(rec.Payload))
+		return nil
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, []string{`"a"`}, sent)
+
+	// the remaining records are replayed with the next attempt
+	assert.Equal(t, []string{`"b"`, `"c"`}, replayAll(t, sp))
+}
+
+func TestSpool_MaxAge(t *testing.T) {
+	sp := newTestSpool(t, SpoolOptions{MaxAge: time.Minute})
+
+	now := time.Now()
+	sp.now = func() time.Time { return now }
+
+	require.NoError(t, sp.Append(spoolSpans, "old"))
+
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, sp.Append(spoolSpans, "recent"))
+
+	assert.Equal(t, []string{`"recent"`}, replayAll(t, sp))
+}
+
+func TestSpool_MaxSize(t *testing.T) {
+	payload := strings.Repeat("x", 100)
+	recSize := int64(len(encodeSpoolRecord(spoolRecord{Payload: json.RawMessage(`"` + payload + `"`)})))
+
+	sp := newTestSpool(t, SpoolOptions{
+		MaxSize:     3 * recSize,
+		SegmentSize: recSize,
+	})
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, sp.Append(spoolSpans, payload))
+	}
+
+	assert.Equal(t, 3, sp.Len())
+	assert.Len(t, replayAll(t, sp), 3)
+
+	assert.Error(t, sp.Append(spoolSpans, strings.Repeat(payload, 4)), "a record larger than the spool is rejected")
+}
+
+type spoolAgentServer struct {
+	mu        sync.Mutex
+	available bool
+	requests  map[string][]string
+}
+
+func (srv *spoolAgentServer) Do(req *http.Request) (*http.Response, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if !srv.available {
+		return nil, errors.New("connection refused")
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if srv.requests == nil {
+		srv.requests = make(map[string][]string)
+	}
+	srv.requests[req.URL.Path] = append(srv.requests[req.URL.Path], string(body))
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+	}, nil
+}
+
+func TestAgentS_Spool(t *testing.T) {
+	srv := &spoolAgentServer{}
+
+	agent := newAgent("test-service", "127.0.0.1", 1, defaultLogger)
+	agent.agentComm = &agentCommunicator{
+		host:   "localhost",
+		port:   "42699",
+		from:   &fromS{EntityID: "123", HostID: "host"},
+		client: srv,
+		l:      defaultLogger,
+	}
+	agent.setSpool(newTestSpool(t, SpoolOptions{Dir: filepath.Join(t.TempDir(), "spool")}))
+
+	require.NoError(t, agent.SendSpans([]Span{{TraceID: 1, SpanID: 2, Name: "sdk", Data: SDKSpanData{}}}))
+	require.NoError(t, agent.SendProfiles([]autoprofile.Profile{{ID: "profile"}}))
+	require.NoError(t, agent.SendEvent(&EventData{Title: "event"}))
+
+	assert.Equal(t, 1, agent.getSpool().Len())
+
+	// the agent process has been restarted and got a new entity ID
+	srv.available = true
+	agent.agentComm.from = &fromS{EntityID: "456", HostID: "host"}
+
+	agent.replaySpool()
+
+	assert.Equal(t, 0, agent.getSpool().Len())
+
+	require.Len(t, srv.requests[agentTracesURL+"456"], 1)
+	assert.JSONEq(t, `[{
+		"t": "0000000000000001",
+		"s": "0000000000000002",
+		"ts": 0,
+		"d": 0,
+		"n": "sdk",
+		"f": {"e": "456", "h": "host"},
+		"k": 0,
+		"data": {"sdk": {"name": ""}}
+	}]`, srv.requests[agentTracesURL+"456"][0])
+
+	require.Len(t, srv.requests[agentProfilesURL+"456"], 1)
+	assert.Contains(t, srv.requests[agentProfilesURL+"456"][0], `"id":"profile"`)
+	assert.Contains(t, srv.requests[agentProfilesURL+"456"][0], `"pid":"456"`)
+
+	require.Len(t, srv.requests[agentEventURL], 1)
+	assert.Contains(t, srv.requests[agentEventURL][0], `"title":"event"`)
+}
+
+func TestAgentS_SendSpans_SpoolDisabled(t *testing.T) {
+	agent := newAgent("test-service", "127.0.0.1", 1, defaultLogger)
+	agent.agentComm = &agentCommunicator{
+		host:   "localhost",
+		port:   "42699",
+		from:   &fromS{EntityID: "123"},
+		client: &spoolAgentServer{},
+		l:      defaultLogger,
+	}
+
+	assert.Error(t, agent.SendSpans([]Span{{TraceID: 1, SpanID: 2}}))
+}
+
+func TestSpool_Replay_ConcurrentAppend(t *testing.T) {
+	sp := newTestSpool(t, SpoolOptions{})
+
+	require.NoError(t, sp.Append(spoolSpans, "first"))
+
+	var replayed []string
+	require.NoError(t, sp.Replay(func(rec spoolRecord) error {
+		replayed = append(replayed, string(rec.Payload))
+
+		// the spool must not be locked while the record is being sent
+		appended := make(chan error, 1)
+		go func() { appended <- sp.Append(spoolSpans, "second") }()
+
+		select {
+		case err := <-appended:
+			return err
+		case <-time.After(time.Second):
+			t.Error("append is blocked by the replay")
+			return nil
+		}
+	}))
+
+	// the record appended during the replay is kept for the next one
+	assert.Equal(t, []string{`"first"`}, replayed)
+	assert.Equal(t, []string{`"second"`}, replayAll(t, sp))
+}
+
+func TestShutdownCollector_ClosesSpool(t *testing.T) {
+	InitCollector(&Options{
+		Service: "go-sensor-test",
+		Spool: SpoolOptions{
+			Dir: t.TempDir(),
+		},
+	})
+
+	s, err := getSensor()
+	require.NoError(t, err)
+
+	agent, ok := s.Agent().(*agentS)
+	require.True(t, ok)
+
+	sp := agent.getSpool()
+	require.NotNil(t, sp)
+	require.NoError(t, sp.Append(spoolSpans, "span"))
+
+	ShutdownCollector()
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	assert.Nil(t, sp.active)
+}
@@ -0,0 +1,64 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	ot "github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// sqlTransactionOperationName is the operation name of the span covering a database/sql transaction
+const sqlTransactionOperationName = "sql.transaction"
+
+type wConnBeginTx struct {
+	driver.ConnBeginTx
+	connDetails DbConnDetails
+	sensor      TracerLogger
+}
+
+// BeginTx starts a transaction span that parents the BEGIN, COMMIT or ROLLBACK statement spans, as well as
+// all statements executed on the connection until the transaction is over. No spans are created if ctx
+// does not contain an active span.
+//
+// Transactions are only traced for the drivers that implement driver.ConnBeginTx. The transactions started
+// with the legacy (driver.Conn).Begin() are passed through as is, since it does not accept a context to take
+// the parent span from, and the statements executed within them are traced as if there was no transaction.
+func (conn *wConnBeginTx) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	parentSpan, ok := SpanFromContext(ctx)
+	if !ok {
+		return conn.ConnBeginTx.BeginTx(ctx, opts)
+	}
+
+	txSpan := conn.sensor.StartSpan(sqlTransactionOperationName, ot.ChildOf(parentSpan.Context()), ot.Tags{
+		"sql.isolation_level": sql.IsolationLevel(opts.Isolation).String(),
+		"sql.read_only":       opts.ReadOnly,
+	})
+
+	sp, dbKey := getSQLSpanData(conn.connDetails, withQuery("BEGIN")).start(ContextWithSpan(ctx, txSpan), conn.sensor)
+
+	tx, err := conn.ConnBeginTx.BeginTx(ctx, opts)
+	if err != nil {
+		sp.LogFields(otlog.Error(err))
+		sp.SetTag(dbKey+".error", err.Error())
+		sp.Finish()
+
+		txSpan.LogFields(otlog.Error(err))
+		txSpan.Finish()
+
+		return tx, err
+	}
+
+	sp.Finish()
+	conn.connDetails.tx.start(txSpan)
+
+	return &wTx{
+		Tx:          tx,
+		span:        txSpan,
+		connDetails: conn.connDetails,
+		sensor:      conn.sensor,
+	}, nil
+}
@@ -36,5 +36,9 @@ func (conn *wExecer) Exec(query string, args []driver.Value) (driver.Result, err
 		sp.SetTag(dbKey+".error", err.Error())
 	}
 
+	if err == nil {
+		setRowsAffected(sp, dbKey, res)
+	}
+
 	return res, err
 }
@@ -32,5 +32,9 @@ func (conn *wExecerContext) ExecContext(ctx context.Context, query string, args
 		sp.SetTag(dbKey+".error", err.Error())
 	}
 
+	if err == nil {
+		setRowsAffected(sp, dbKey, res)
+	}
+
 	return res, err
 }
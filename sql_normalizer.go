@@ -0,0 +1,443 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SQLDialect defines the SQL syntax rules used to tokenize a statement
+type SQLDialect uint8
+
+// Supported SQL dialects
+const (
+	// GenericSQLDialect follows the ANSI SQL syntax
+	GenericSQLDialect SQLDialect = iota
+	// PostgreSQLDialect adds support for dollar-quoted strings, E'' escape strings and $n placeholders
+	PostgreSQLDialect
+	// MySQLDialect adds support for double-quoted strings, backslash escapes, `quoted` identifiers and # comments
+	MySQLDialect
+	// OracleDialect adds support for q'[...]' alternative quoting and :name placeholders
+	OracleDialect
+	// DB2Dialect follows the ANSI SQL syntax with the support of :name placeholders
+	DB2Dialect
+)
+
+// String returns the name of a dialect
+func (d SQLDialect) String() string {
+	switch d {
+	case PostgreSQLDialect:
+		return "postgres"
+	case MySQLDialect:
+		return "mysql"
+	case OracleDialect:
+		return "oracle"
+	case DB2Dialect:
+		return "db2"
+	default:
+		return "sql"
+	}
+}
+
+// sqlDialectByName returns the SQL dialect for a database or driver name, falling back to the generic one
+func sqlDialectByName(name string) SQLDialect {
+	switch strings.ToLower(name) {
+	case "postgres", "postgresql", "pg", "pgx":
+		return PostgreSQLDialect
+	case "mysql", "mariadb":
+		return MySQLDialect
+	case "oracle", "godror", "oci8":
+		return OracleDialect
+	case "db2", "go_ibm_db":
+		return DB2Dialect
+	default:
+		return GenericSQLDialect
+	}
+}
+
+type sqlTokenKind uint8
+
+const (
+	sqlTokenWhitespace sqlTokenKind = iota
+	sqlTokenComment
+	sqlTokenWord
+	sqlTokenQuotedIdentifier
+	sqlTokenString
+	sqlTokenNumber
+	sqlTokenPlaceholder
+	sqlTokenPunctuation
+)
+
+type sqlToken struct {
+	Kind sqlTokenKind
+	Text string
+}
+
+// isLiteral reports whether the token is a value that is replaced by the normalizer
+func (t sqlToken) isLiteral() bool {
+	return t.Kind == sqlTokenString || t.Kind == sqlTokenNumber
+}
+
+// NormalizeSQL returns the SQL statement with string and numeric literals replaced with `?`. Lists
+// of values in `IN (...)` clauses are collapsed to a single `(?)`, and comments are removed, so that
+// statements that only differ in the literal values are normalized to the same string.
+func NormalizeSQL(query string, dialect SQLDialect) string {
+	tokens := tokenizeSQL(query, dialect)
+
+	var buf strings.Builder
+	buf.Grow(len(query))
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+
+		switch {
+		case tok.Kind == sqlTokenComment:
+			continue
+		case tok.isLiteral():
+			buf.WriteByte('?')
+			continue
+		case tok.Kind == sqlTokenWord && strings.EqualFold(tok.Text, "IN"):
+			buf.WriteString(tok.Text)
+
+			if end, ok := matchSQLValueList(tokens, i+1); ok {
+				buf.WriteString(" (?)")
+				i = end
+			}
+
+			continue
+		}
+
+		buf.WriteString(tok.Text)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
+
+// matchSQLValueList checks whether tokens starting at the given position form a parenthesized list of
+// literals and placeholders, and returns the position of the closing parenthesis
+func matchSQLValueList(tokens []sqlToken, start int) (int, bool) {
+	i := skipSQLWhitespace(tokens, start)
+	if i >= len(tokens) || tokens[i].Text != "(" {
+		return 0, false
+	}
+
+	expectValue := true
+	for i = skipSQLWhitespace(tokens, i+1); i < len(tokens); i = skipSQLWhitespace(tokens, i+1) {
+		tok := tokens[i]
+
+		switch {
+		case expectValue && (tok.isLiteral() || tok.Kind == sqlTokenPlaceholder):
+			expectValue = false
+		case !expectValue && tok.Text == ",":
+			expectValue = true
+		case !expectValue && tok.Text == ")":
+			return i, true
+		default:
+			return 0, false
+		}
+	}
+
+	return 0, false
+}
+
+func skipSQLWhitespace(tokens []sqlToken, i int) int {
+	for i < len(tokens) && (tokens[i].Kind == sqlTokenWhitespace || tokens[i].Kind == sqlTokenComment) {
+		i++
+	}
+
+	return i
+}
+
+// tokenizeSQL splits an SQL statement into a list of tokens. Malformed input, such as an unterminated string,
+// is consumed till the end of the statement.
+func tokenizeSQL(s string, dialect SQLDialect) []sqlToken {
+	var tokens []sqlToken
+
+	for i := 0; i < len(s); {
+		kind, n := scanSQLToken(s[i:], dialect)
+		if n == 0 {
+			n = 1
+		}
+
+		// treat the sign of a number as a part of the literal if it follows an operator or a keyword
+		if kind == sqlTokenNumber && len(tokens) > 0 && tokens[len(tokens)-1].Text == "-" && isSQLUnaryMinus(tokens) {
+			tokens = tokens[:len(tokens)-1]
+		}
+
+		tokens = append(tokens, sqlToken{Kind: kind, Text: s[i : i+n]})
+		i += n
+	}
+
+	return tokens
+}
+
+// isSQLUnaryMinus checks whether the last token, which is a minus sign, is an unary operator
+func isSQLUnaryMinus(tokens []sqlToken) bool {
+	i := len(tokens) - 2
+	for i >= 0 && tokens[i].Kind == sqlTokenWhitespace {
+		i--
+	}
+
+	if i < 0 {
+		return true
+	}
+
+	switch prev := tokens[i]; prev.Kind {
+	case sqlTokenPunctuation:
+		return prev.Text != ")"
+	case sqlTokenWord:
+		return isSQLKeyword(prev.Text)
+	default:
+		return false
+	}
+}
+
+// isSQLKeyword returns true for the keywords that may precede a value
+func isSQLKeyword(s string) bool {
+	switch strings.ToUpper(s) {
+	case "SELECT", "WHERE", "AND", "OR", "NOT", "VALUES", "SET", "LIMIT", "OFFSET", "BETWEEN", "LIKE",
+		"IN", "IS", "ON", "WHEN", "THEN", "ELSE", "RETURN", "HAVING", "BY":
+		return true
+	default:
+		return false
+	}
+}
+
+// scanSQLToken returns the kind and the length of the token at the start of s
+func scanSQLToken(s string, dialect SQLDialect) (sqlTokenKind, int) {
+	c := s[0]
+
+	switch {
+	case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+		n := 1
+		for n < len(s) && (s[n] == ' ' || s[n] == '\t' || s[n] == '\n' || s[n] == '\r' || s[n] == '\f') {
+			n++
+		}
+
+		return sqlTokenWhitespace, n
+	case strings.HasPrefix(s, "--"), c == '#' && dialect == MySQLDialect:
+		n := strings.IndexByte(s, '\n')
+		if n < 0 {
+			n = len(s)
+		}
+
+		return sqlTokenComment, n
+	case strings.HasPrefix(s, "/*"):
+		n := strings.Index(s[2:], "*/")
+		if n < 0 {
+			return sqlTokenComment, len(s)
+		}
+
+		return sqlTokenComment, n + 4
+	case c == '\'':
+		return sqlTokenString, scanSQLQuoted(s, '\'', dialect == MySQLDialect)
+	case c == '"':
+		if dialect == MySQLDialect {
+			return sqlTokenString, scanSQLQuoted(s, '"', true)
+		}
+
+		return sqlTokenQuotedIdentifier, scanSQLQuoted(s, '"', false)
+	case c == '`' && dialect == MySQLDialect:
+		return sqlTokenQuotedIdentifier, scanSQLQuoted(s, '`', false)
+	case c == '[' && dialect == GenericSQLDialect:
+		n := strings.IndexByte(s, ']')
+		if n < 0 {
+			return sqlTokenQuotedIdentifier, len(s)
+		}
+
+		return sqlTokenQuotedIdentifier, n + 1
+	case c >= '0' && c <= '9', c == '.' && len(s) > 1 && s[1] >= '0' && s[1] <= '9':
+		return sqlTokenNumber, scanSQLNumber(s)
+	case c == '?':
+		return sqlTokenPlaceholder, 1
+	case c == '$' && dialect == PostgreSQLDialect:
+		if n := scanSQLDigits(s[1:]); n > 0 {
+			return sqlTokenPlaceholder, n + 1
+		}
+
+		if n := scanSQLDollarQuoted(s); n > 0 {
+			return sqlTokenString, n
+		}
+
+		return sqlTokenPunctuation, 1
+	case c == ':' || c == '@':
+		if len(s) > 1 && s[1] == c {
+			// PostgreSQL :: type casts and @@ system variables
+			return sqlTokenPunctuation, 2
+		}
+
+		if n := scanSQLWord(s[1:]); n > 0 {
+			return sqlTokenPlaceholder, n + 1
+		}
+
+		return sqlTokenPunctuation, 1
+	case isSQLWordStart(s):
+		return scanSQLWordToken(s, dialect)
+	}
+
+	_, n := utf8.DecodeRuneInString(s)
+
+	return sqlTokenPunctuation, n
+}
+
+// scanSQLWordToken scans a keyword or identifier, handling the prefixed string literals, such as
+// E'...', X'...', N'...' and Oracle q'[...]'
+func scanSQLWordToken(s string, dialect SQLDialect) (sqlTokenKind, int) {
+	if len(s) > 1 && s[1] == '\'' {
+		switch s[0] {
+		case 'E', 'e':
+			if dialect == PostgreSQLDialect {
+				return sqlTokenString, 1 + scanSQLQuoted(s[1:], '\'', true)
+			}
+
+			return sqlTokenString, 1 + scanSQLQuoted(s[1:], '\'', false)
+		case 'X', 'x', 'B', 'b', 'N', 'n':
+			return sqlTokenString, 1 + scanSQLQuoted(s[1:], '\'', false)
+		case 'Q', 'q':
+			if dialect == OracleDialect {
+				return sqlTokenString, 1 + scanOracleAltQuoted(s[1:])
+			}
+		}
+	}
+
+	if len(s) > 2 && (s[0] == 'N' || s[0] == 'n') && (s[1] == 'Q' || s[1] == 'q') && s[2] == '\'' && dialect == OracleDialect {
+		return sqlTokenString, 2 + scanOracleAltQuoted(s[2:])
+	}
+
+	return sqlTokenWord, scanSQLWord(s)
+}
+
+// scanSQLQuoted returns the length of a quoted string starting with the quote character. The quote
+// is escaped by doubling it. If backslashEscapes is true, a backslash escapes the following character.
+func scanSQLQuoted(s string, quote byte, backslashEscapes bool) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if backslashEscapes {
+				i++
+			}
+		case quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+
+			return i + 1
+		}
+	}
+
+	return len(s)
+}
+
+// scanSQLDollarQuoted returns the length of a PostgreSQL dollar-quoted string, i.e. $$...$$ or $tag$...$tag$,
+// or 0 if s does not start with a dollar quote
+func scanSQLDollarQuoted(s string) int {
+	end := strings.IndexByte(s[1:], '$')
+	if end < 0 {
+		return 0
+	}
+
+	tag := s[:end+2]
+	for _, r := range tag[1 : len(tag)-1] {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return 0
+		}
+	}
+
+	n := strings.Index(s[len(tag):], tag)
+	if n < 0 {
+		return len(s)
+	}
+
+	return len(tag) + n + len(tag)
+}
+
+// scanOracleAltQuoted returns the length of an Oracle alternative quoted string, i.e. '[...]', '{...}', '<...>',
+// '(...)' or a string quoted by any other character
+func scanOracleAltQuoted(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+
+	closing := s[1]
+	switch closing {
+	case '[':
+		closing = ']'
+	case '{':
+		closing = '}'
+	case '<':
+		closing = '>'
+	case '(':
+		closing = ')'
+	}
+
+	n := strings.Index(s[2:], string([]byte{closing, '\''}))
+	if n < 0 {
+		return len(s)
+	}
+
+	return n + 4
+}
+
+func scanSQLNumber(s string) int {
+	if len(s) > 1 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		n := 2
+		for n < len(s) && isHexDigit(s[n]) {
+			n++
+		}
+
+		return n
+	}
+
+	n := scanSQLDigits(s)
+	if n < len(s) && s[n] == '.' {
+		n += 1 + scanSQLDigits(s[n+1:])
+	}
+
+	if n < len(s) && (s[n] == 'e' || s[n] == 'E') {
+		m := n + 1
+		if m < len(s) && (s[m] == '+' || s[m] == '-') {
+			m++
+		}
+
+		if d := scanSQLDigits(s[m:]); d > 0 {
+			n = m + d
+		}
+	}
+
+	return n
+}
+
+func scanSQLDigits(s string) int {
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+
+	return n
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isSQLWordStart(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func scanSQLWord(s string) int {
+	n := 0
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if r != '_' && r != '$' && r != '#' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+
+		n += size
+	}
+
+	return n
+}
@@ -0,0 +1,118 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	examples := map[string]struct {
+		Dialect  instana.SQLDialect
+		Query    string
+		Expected string
+	}{
+		"string and numeric literals": {
+			Dialect:  instana.GenericSQLDialect,
+			Query:    "SELECT * FROM users WHERE name = 'O''Brien' AND age > 42 AND score < -1.5e3",
+			Expected: "SELECT * FROM users WHERE name = ? AND age > ? AND score < ?",
+		},
+		"in list": {
+			Dialect:  instana.GenericSQLDialect,
+			Query:    "SELECT id FROM orders WHERE status IN ('new', 'paid', 'shipped') AND id in (1,2 , 3)",
+			Expected: "SELECT id FROM orders WHERE status IN (?) AND id in (?)",
+		},
+		"in subquery": {
+			Dialect:  instana.GenericSQLDialect,
+			Query:    "SELECT id FROM orders WHERE user_id IN (SELECT id FROM users WHERE age > 18)",
+			Expected: "SELECT id FROM orders WHERE user_id IN (SELECT id FROM users WHERE age > ?)",
+		},
+		"comments": {
+			Dialect:  instana.GenericSQLDialect,
+			Query:    "SELECT 1 /* user: jdoe */ -- trailing comment\nFROM dual",
+			Expected: "SELECT ?  \nFROM dual",
+		},
+		"identifiers and binary minus": {
+			Dialect:  instana.GenericSQLDialect,
+			Query:    `SELECT t1.col2 - 1 FROM "Table 3" t1`,
+			Expected: `SELECT t1.col2 - ? FROM "Table 3" t1`,
+		},
+		"hex and national strings": {
+			Dialect:  instana.GenericSQLDialect,
+			Query:    "INSERT INTO blobs VALUES (X'DEADBEEF', N'name', 0x1F)",
+			Expected: "INSERT INTO blobs VALUES (?, ?, ?)",
+		},
+		"postgres": {
+			Dialect:  instana.PostgreSQLDialect,
+			Query:    "SELECT $1::text, E'it\\'s', $$secret$$, $tag$x$tag$ FROM t WHERE id IN ($2, $3)",
+			Expected: "SELECT $1::text, ?, ?, ? FROM t WHERE id IN (?)",
+		},
+		"mysql": {
+			Dialect:  instana.MySQLDialect,
+			Query:    "SELECT `name` FROM users WHERE email = \"jdoe@example.com\" AND note = 'it\\'s' # comment",
+			Expected: "SELECT `name` FROM users WHERE email = ? AND note = ?",
+		},
+		"oracle": {
+			Dialect:  instana.OracleDialect,
+			Query:    "UPDATE accounts SET note = q'[it's]' WHERE id = :id AND balance > 100",
+			Expected: "UPDATE accounts SET note = ? WHERE id = :id AND balance > ?",
+		},
+		"db2": {
+			Dialect:  instana.DB2Dialect,
+			Query:    "SELECT * FROM SYSIBM.SYSDUMMY1 WHERE A = 'x' FETCH FIRST 10 ROWS ONLY",
+			Expected: "SELECT * FROM SYSIBM.SYSDUMMY1 WHERE A = ? FETCH FIRST ? ROWS ONLY",
+		},
+		"unterminated string": {
+			Dialect:  instana.GenericSQLDialect,
+			Query:    "SELECT 'secret",
+			Expected: "SELECT ?",
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, example.Expected, instana.NormalizeSQL(example.Query, example.Dialect))
+		})
+	}
+}
+
+func TestNormalizeMongoCommand(t *testing.T) {
+	examples := map[string]struct {
+		Command  string
+		Expected string
+	}{
+		"filter": {
+			Command:  `{"name": "jdoe", "age": {"$gt": 18}, "active": true, "deleted": null}`,
+			Expected: `{"name":"?","age":{"$gt":"?"},"active":true,"deleted":null}`,
+		},
+		"in list": {
+			Command:  `{"status": {"$in": ["new", "paid"]}, "tags": []}`,
+			Expected: `{"status":{"$in":["?"]},"tags":[]}`,
+		},
+		"aggregation": {
+			Command:  `[{"$match": {"age": 42}}, {"$group": {"_id": "$city", "total": {"$sum": 1}}}]`,
+			Expected: `[{"$match":{"age":"?"}},{"$group":{"_id":"$city","total":{"$sum":"?"}}}]`,
+		},
+		"command": {
+			Command:  `{"find": "users", "filter": {"name": "bob", "find": "me"}, "limit": 10, "$db": "app"}`,
+			Expected: `{"find":"users","filter":{"name":"?","find":"?"},"limit":"?","$db":"app"}`,
+		},
+		"aggregate command": {
+			Command:  `{"aggregate": "orders", "pipeline": [{"$match": {"total": 42}}], "$db": "shop"}`,
+			Expected: `{"aggregate":"orders","pipeline":[{"$match":{"total":"?"}}],"$db":"shop"}`,
+		},
+		"invalid json": {
+			Command:  `{"name": `,
+			Expected: `{"name": `,
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, example.Expected, instana.NormalizeMongoCommand(example.Command))
+		})
+	}
+}
@@ -26,15 +26,21 @@ func (conn *wQueryer) Query(query string, args []driver.Value) (driver.Rows, err
 	conn.sqlSpan.updateDBQuery(query)
 
 	sp, dbKey := conn.sqlSpan.start(ctx, conn.sensor)
-	defer sp.Finish()
 
 	res, err := conn.Queryer.Query(query, args)
 
-	if err != nil && err != driver.ErrSkip {
-		sp.LogFields(otlog.Error(err))
-		sp.SetTag(dbKey+".error", err.Error())
+	if err != nil {
+		if err != driver.ErrSkip {
+			sp.LogFields(otlog.Error(err))
+			sp.SetTag(dbKey+".error", err.Error())
+		}
+
+		sp.Finish()
+
+		return res, err
 	}
 
-	return res, err
+	// the span is finished once the rows are closed
+	return wrapRows(res, sp, dbKey), nil
 
 }
@@ -0,0 +1,135 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"sync"
+
+	ot "github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// wRows is a wrapper over the rows returned by a query that keeps the query span open until the rows are closed,
+// so that the span duration includes the time spent streaming the result set. The optional interfaces are
+// delegated to the driver rows, falling back to the values database/sql uses for the drivers that do not
+// implement them.
+type wRows struct {
+	driver.Rows
+
+	span  ot.Span
+	dbKey string
+
+	count      int
+	finishOnce sync.Once
+}
+
+// wrapRows returns the rows that finish the span once closed. The span is finished right away if there
+// are no rows to wrap.
+func wrapRows(rows driver.Rows, sp ot.Span, dbKey string) driver.Rows {
+	if rows == nil {
+		sp.Finish()
+		return rows
+	}
+
+	return &wRows{
+		Rows:  rows,
+		span:  sp,
+		dbKey: dbKey,
+	}
+}
+
+func (r *wRows) Next(dest []driver.Value) error {
+	err := r.Rows.Next(dest)
+
+	switch err {
+	case nil:
+		r.count++
+	case io.EOF:
+	default:
+		r.span.LogFields(otlog.Error(err))
+		r.span.SetTag(r.dbKey+".error", err.Error())
+	}
+
+	return err
+}
+
+func (r *wRows) Close() error {
+	err := r.Rows.Close()
+
+	r.finishOnce.Do(func() {
+		r.span.SetTag(r.dbKey+".rows", r.count)
+		r.span.Finish()
+	})
+
+	return err
+}
+
+func (r *wRows) HasNextResultSet() bool {
+	if rs, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return rs.HasNextResultSet()
+	}
+
+	return false
+}
+
+func (r *wRows) NextResultSet() error {
+	if rs, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return rs.NextResultSet()
+	}
+
+	return io.EOF
+}
+
+func (r *wRows) ColumnTypeScanType(index int) reflect.Type {
+	if rs, ok := r.Rows.(driver.RowsColumnTypeScanType); ok {
+		return rs.ColumnTypeScanType(index)
+	}
+
+	return reflect.TypeOf((*interface{})(nil)).Elem()
+}
+
+func (r *wRows) ColumnTypeDatabaseTypeName(index int) string {
+	if rs, ok := r.Rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		return rs.ColumnTypeDatabaseTypeName(index)
+	}
+
+	return ""
+}
+
+func (r *wRows) ColumnTypeLength(index int) (int64, bool) {
+	if rs, ok := r.Rows.(driver.RowsColumnTypeLength); ok {
+		return rs.ColumnTypeLength(index)
+	}
+
+	return 0, false
+}
+
+func (r *wRows) ColumnTypeNullable(index int) (bool, bool) {
+	if rs, ok := r.Rows.(driver.RowsColumnTypeNullable); ok {
+		return rs.ColumnTypeNullable(index)
+	}
+
+	return false, false
+}
+
+func (r *wRows) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	if rs, ok := r.Rows.(driver.RowsColumnTypePrecisionScale); ok {
+		return rs.ColumnTypePrecisionScale(index)
+	}
+
+	return 0, 0, false
+}
+
+// setRowsAffected sets the number of rows affected by a statement as a span tag, if the driver reports it
+func setRowsAffected(sp ot.Span, dbKey string, res driver.Result) {
+	if res == nil {
+		return
+	}
+
+	if n, err := res.RowsAffected(); err == nil {
+		sp.SetTag(dbKey+".rows_affected", n)
+	}
+}
@@ -28,5 +28,9 @@ func (stmt *wStmtExecContext) ExecContext(ctx context.Context, args []driver.Nam
 		sp.SetTag(dbKey+".error", err.Error())
 	}
 
+	if err == nil {
+		setRowsAffected(sp, dbKey, res)
+	}
+
 	return res, err
 }
@@ -19,15 +19,21 @@ type wStmtQueryContext struct {
 func (stmt *wStmtQueryContext) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
 
 	sp, dbKey := stmt.sqlSpan.start(ctx, stmt.sensor)
-	defer sp.Finish()
 
 	res, err := stmt.StmtQueryContext.QueryContext(ctx, args)
 
-	if err != nil && err != driver.ErrSkip {
-		sp.LogFields(otlog.Error(err))
-		sp.SetTag(dbKey+".error", err.Error())
+	if err != nil {
+		if err != driver.ErrSkip {
+			sp.LogFields(otlog.Error(err))
+			sp.SetTag(dbKey+".error", err.Error())
+		}
+
+		sp.Finish()
+
+		return res, err
 	}
 
-	return res, err
+	// the span is finished once the rows are closed
+	return wrapRows(res, sp, dbKey), nil
 
 }
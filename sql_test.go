@@ -387,6 +387,13 @@ func Test_wQueryerContext_QueryContext(t *testing.T) {
 				t.Errorf("wQueryerContext.QueryContext() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+
+			// the query span is finished once the rows are closed
+			// the rows of a successful query are wrapped to keep the span open until they are closed
+			if wrapped, ok := got.(*wRows); ok {
+				got = wrapped.Rows
+			}
+
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("wQueryerContext.QueryContext() = %v, want %v", got, tt.want)
 			}
@@ -475,6 +482,13 @@ func Test_wQueryer_Query(t *testing.T) {
 				t.Errorf("wQueryer.Query() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+
+			// the query span is finished once the rows are closed
+			// the rows of a successful query are wrapped to keep the span open until they are closed
+			if wrapped, ok := got.(*wRows); ok {
+				got = wrapped.Rows
+			}
+
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("wQueryer.Query() = %v, want %v", got, tt.want)
 			}
@@ -587,6 +601,13 @@ func Test_wStmt_Query(t *testing.T) {
 				t.Errorf("wStmt.Query() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+
+			// the query span is finished once the rows are closed
+			// the rows of a successful query are wrapped to keep the span open until they are closed
+			if wrapped, ok := got.(*wRows); ok {
+				got = wrapped.Rows
+			}
+
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("wStmt.Query() = %v, want %v", got, tt.want)
 			}
@@ -0,0 +1,86 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+
+	ot "github.com/opentracing/opentracing-go"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+// sqlTxState keeps track of the transaction in progress on a wrapped connection. A connection is used by one
+// goroutine at a time, however database/sql may roll back a transaction from another goroutine once the
+// context passed to BeginTx is cancelled.
+type sqlTxState struct {
+	mu     sync.Mutex
+	txSpan ot.Span
+}
+
+func (st *sqlTxState) start(sp ot.Span) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.txSpan = sp
+}
+
+func (st *sqlTxState) end() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.txSpan = nil
+}
+
+// span returns the span of the transaction in progress. It is safe to call this method on a nil state,
+// i.e. for the connection details that do not belong to a wrapped connection.
+func (st *sqlTxState) span() (ot.Span, bool) {
+	if st == nil {
+		return nil, false
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return st.txSpan, st.txSpan != nil
+}
+
+type wTx struct {
+	driver.Tx
+
+	span        ot.Span
+	connDetails DbConnDetails
+	sensor      TracerLogger
+}
+
+func (tx *wTx) Commit() error {
+	return tx.end("COMMIT", "commit", tx.Tx.Commit)
+}
+
+func (tx *wTx) Rollback() error {
+	return tx.end("ROLLBACK", "rollback", tx.Tx.Rollback)
+}
+
+// end reports the call to fn as a statement span and finishes the transaction span with the outcome
+func (tx *wTx) end(query, outcome string, fn func() error) error {
+	sp, dbKey := getSQLSpanData(tx.connDetails, withQuery(query)).start(context.Background(), tx.sensor)
+
+	err := fn()
+	if err != nil {
+		sp.LogFields(otlog.Error(err))
+		sp.SetTag(dbKey+".error", err.Error())
+	}
+
+	sp.Finish()
+	tx.connDetails.tx.end()
+
+	tx.span.SetTag("sql.outcome", outcome)
+	if err != nil {
+		tx.span.LogFields(otlog.Error(err))
+	}
+
+	tx.span.Finish()
+
+	return err
+}
@@ -0,0 +1,236 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const txTestDSN = "postgres://user1@db-host:5432/test-schema"
+
+var txDriverSeq atomic.Int64
+
+// uniqueDriverName returns a new driver name for each call, since an instrumented driver stays registered
+// with the collector it has been instrumented for, i.e. when the test is run with -count=N
+func uniqueDriverName(t *testing.T) string {
+	return fmt.Sprintf("%s_%d", t.Name(), txDriverSeq.Add(1))
+}
+
+func TestSQLTransaction_Commit(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     "go-sensor-test",
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	driverName := uniqueDriverName(t)
+	instana.InstrumentSQLDriver(c, driverName, txDriver{})
+
+	db, err := instana.SQLOpen(driverName, txTestDSN)
+	require.NoError(t, err)
+
+	parentSpan := c.Tracer().StartSpan("parent-span")
+	ctx := instana.ContextWithSpan(context.Background(), parentSpan)
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	require.NoError(t, err)
+
+	// the statements executed within a transaction are its children even without a span in context
+	_, err = tx.ExecContext(context.Background(), "UPDATE users SET active = true")
+	require.NoError(t, err)
+
+	require.NoError(t, tx.Commit())
+	parentSpan.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 5)
+
+	parent, txSpan := spans[4], spans[3]
+	assert.Equal(t, parent.SpanID, txSpan.ParentID)
+	assert.Equal(t, 0, txSpan.Ec)
+
+	require.IsType(t, instana.SDKSpanData{}, txSpan.Data)
+	txData := txSpan.Data.(instana.SDKSpanData)
+
+	assert.Equal(t, "sql.transaction", txData.Tags.Name)
+
+	require.IsType(t, ot.Tags{}, txData.Tags.Custom["tags"])
+	txTags := txData.Tags.Custom["tags"].(ot.Tags)
+
+	assert.Equal(t, "Serializable", txTags["sql.isolation_level"])
+	assert.Equal(t, false, txTags["sql.read_only"])
+	assert.Equal(t, "commit", txTags["sql.outcome"])
+
+	var stmts []string
+	for _, sp := range spans[:3] {
+		assert.Equal(t, txSpan.SpanID, sp.ParentID)
+		assert.Equal(t, txSpan.TraceID, sp.TraceID)
+
+		require.IsType(t, instana.PostgreSQLSpanData{}, sp.Data)
+		stmts = append(stmts, sp.Data.(instana.PostgreSQLSpanData).Tags.Stmt)
+	}
+
+	assert.Equal(t, []string{"BEGIN", "UPDATE users SET active = true", "COMMIT"}, stmts)
+	assert.Equal(t, 3, spans[1].Data.(instana.PostgreSQLSpanData).Tags.RowsAffected)
+}
+
+func TestSQLTransaction_RollbackError(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     "go-sensor-test",
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	driverName := uniqueDriverName(t)
+	instana.InstrumentSQLDriver(c, driverName, txDriver{RollbackError: errors.New("connection reset")})
+
+	db, err := instana.SQLOpen(driverName, txTestDSN)
+	require.NoError(t, err)
+
+	parentSpan := c.Tracer().StartSpan("parent-span")
+	ctx := instana.ContextWithSpan(context.Background(), parentSpan)
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+
+	require.Error(t, tx.Rollback())
+	parentSpan.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	// BEGIN, ROLLBACK and the transaction span, the parent span and the log records of both errors
+	require.Len(t, spans, 6)
+
+	rollbackSpan := spans[1]
+	require.IsType(t, instana.PostgreSQLSpanData{}, rollbackSpan.Data)
+	assert.Equal(t, instana.PostgreSQLSpanTags{
+		Host:  "db-host",
+		Port:  "5432",
+		DB:    "test-schema",
+		User:  "user1",
+		Stmt:  "ROLLBACK",
+		Error: "connection reset",
+	}, rollbackSpan.Data.(instana.PostgreSQLSpanData).Tags)
+
+	txSpan := findSpanByName(t, spans, "sdk")
+	assert.Equal(t, 1, txSpan.Ec)
+
+	require.IsType(t, instana.SDKSpanData{}, txSpan.Data)
+	txData := txSpan.Data.(instana.SDKSpanData)
+
+	require.IsType(t, ot.Tags{}, txData.Tags.Custom["tags"])
+	assert.Equal(t, "rollback", txData.Tags.Custom["tags"].(ot.Tags)["sql.outcome"])
+}
+
+func TestSQLQuery_RowsIteration(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		Service:     "go-sensor-test",
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+	})
+	defer instana.ShutdownCollector()
+
+	driverName := uniqueDriverName(t)
+	instana.InstrumentSQLDriver(c, driverName, txDriver{})
+
+	db, err := instana.SQLOpen(driverName, txTestDSN)
+	require.NoError(t, err)
+
+	parentSpan := c.Tracer().StartSpan("parent-span")
+	ctx := instana.ContextWithSpan(context.Background(), parentSpan)
+
+	rows, err := db.QueryContext(ctx, "SELECT id FROM users")
+	require.NoError(t, err)
+
+	// the span stays open until the rows are closed
+	assert.Empty(t, recorder.GetQueuedSpans())
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		require.NoError(t, rows.Scan(&id))
+
+		ids = append(ids, id)
+	}
+
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 1)
+
+	require.IsType(t, instana.PostgreSQLSpanData{}, spans[0].Data)
+	data := spans[0].Data.(instana.PostgreSQLSpanData)
+
+	assert.Equal(t, "SELECT id FROM users", data.Tags.Stmt)
+	assert.Equal(t, 3, data.Tags.Rows)
+}
+
+type txDriver struct {
+	RollbackError error
+}
+
+func (drv txDriver) Open(string) (driver.Conn, error) { return txConn(drv), nil }
+
+type txConn struct {
+	RollbackError error
+}
+
+func (conn txConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (conn txConn) Close() error                        { return nil }
+func (conn txConn) Begin() (driver.Tx, error) {
+	return conn.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (conn txConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return txTx(conn), nil
+}
+
+func (conn txConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(3), nil
+}
+
+func (conn txConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return &txRows{n: 3}, nil
+}
+
+type txTx struct {
+	RollbackError error
+}
+
+func (txTx) Commit() error      { return nil }
+func (tx txTx) Rollback() error { return tx.RollbackError }
+
+type txRows struct {
+	n, i int
+}
+
+func (*txRows) Columns() []string { return []string{"id"} }
+func (*txRows) Close() error      { return nil }
+
+func (r *txRows) Next(dest []driver.Value) error {
+	if r.i == r.n {
+		return io.EOF
+	}
+
+	r.i++
+	dest[0] = int64(r.i)
+
+	return nil
+}
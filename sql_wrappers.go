@@ -7,6 +7,18 @@ import "database/sql/driver"
 
 // Types
 
+// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.ExecerContext
+	driver.Queryer
+	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
 // [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
 type w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
@@ -18,676 +30,3126 @@ type w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_Named
 	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
 	driver.Queryer
 	driver.QueryerContext
 	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
+	driver.Execer
 	driver.ExecerContext
 	driver.Queryer
 	driver.QueryerContext
-	driver.ConnPrepareContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
-	driver.QueryerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
 	driver.QueryerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
-	driver.ExecerContext
 	driver.Queryer
+	driver.QueryerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.NamedValueChecker]
-type w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker struct {
+// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
-	driver.Execer
 	driver.ExecerContext
 	driver.Queryer
 	driver.QueryerContext
+	driver.ConnPrepareContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.Queryer driver.QueryerContext driver.NamedValueChecker]
-type w_conn_Execer_Queryer_QueryerContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext struct {
 	driver.Conn
 	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
 	driver.QueryerContext
-	driver.NamedValueChecker
+	driver.ConnPrepareContext
 }
 
-// [driver.Execer driver.ExecerContext driver.Queryer driver.ConnPrepareContext]
-type w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.NamedValueChecker]
+type w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
 	driver.Queryer
-	driver.ConnPrepareContext
+	driver.QueryerContext
+	driver.NamedValueChecker
 }
 
-// [driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
+	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
-	driver.QueryerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
+	driver.Execer
 	driver.ExecerContext
 	driver.QueryerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
 	driver.Execer
+	driver.Queryer
 	driver.QueryerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker struct {
+// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
 	driver.ExecerContext
 	driver.Queryer
+	driver.QueryerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
-	driver.ConnPrepareContext
-	driver.NamedValueChecker
+	driver.QueryerContext
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext]
-type w_conn_Execer_ExecerContext_Queryer_QueryerContext struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
 	driver.Queryer
-	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
+	driver.QueryerContext
 	driver.ConnPrepareContext
-	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.NamedValueChecker]
-type w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker struct {
+// [driver.Execer driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
-	driver.ExecerContext
+	driver.Execer
 	driver.Queryer
 	driver.QueryerContext
-	driver.NamedValueChecker
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.QueryerContext driver.NamedValueChecker]
-type w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker struct {
+// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
-	driver.Execer
 	driver.ExecerContext
+	driver.Queryer
 	driver.QueryerContext
-	driver.NamedValueChecker
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.Queryer driver.NamedValueChecker]
-type w_conn_Execer_ExecerContext_Queryer_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
 	driver.Queryer
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext struct {
+// [driver.Execer driver.ExecerContext driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
+	driver.Execer
 	driver.ExecerContext
-	driver.Queryer
 	driver.QueryerContext
-	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext struct {
+// [driver.Execer driver.Queryer driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.Queryer
 	driver.QueryerContext
-	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext struct {
+// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
-	driver.Execer
 	driver.ExecerContext
+	driver.Queryer
 	driver.QueryerContext
-	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Execer_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
+	driver.ExecerContext
 	driver.ConnPrepareContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.Queryer driver.QueryerContext]
-type w_conn_Execer_Queryer_QueryerContext struct {
+// [driver.Execer driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.Queryer
-	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_Execer_QueryerContext_ConnPrepareContext struct {
+// [driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.Queryer
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
+// [driver.Execer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.QueryerContext
 	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_ExecerContext_QueryerContext_ConnPrepareContext struct {
+// [driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.ExecerContext
 	driver.QueryerContext
 	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.Queryer driver.ConnPrepareContext]
-type w_conn_ExecerContext_Queryer_ConnPrepareContext struct {
+// [driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
-	driver.ExecerContext
 	driver.Queryer
+	driver.QueryerContext
 	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_Queryer_QueryerContext_ConnPrepareContext struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.QueryerContext]
+type w_conn_Execer_ExecerContext_Queryer_QueryerContext struct {
 	driver.Conn
+	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
 	driver.QueryerContext
-	driver.ConnPrepareContext
 }
 
-// [driver.Execer driver.Queryer driver.ConnPrepareContext]
-type w_conn_Execer_Queryer_ConnPrepareContext struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.ConnPrepareContext]
+type w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext struct {
 	driver.Conn
 	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
 	driver.ConnPrepareContext
 }
 
-// [driver.Execer driver.ExecerContext driver.QueryerContext]
-type w_conn_Execer_ExecerContext_QueryerContext struct {
+// [driver.Execer driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
 	driver.QueryerContext
+	driver.ConnPrepareContext
 }
 
-// [driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext struct {
 	driver.Conn
+	driver.Execer
+	driver.Queryer
 	driver.QueryerContext
 	driver.ConnPrepareContext
-	driver.NamedValueChecker
 }
 
-// [driver.ExecerContext driver.Queryer driver.QueryerContext]
-type w_conn_ExecerContext_Queryer_QueryerContext struct {
+// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext struct {
 	driver.Conn
 	driver.ExecerContext
 	driver.Queryer
 	driver.QueryerContext
-}
-
-// [driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_Queryer_ConnPrepareContext_NamedValueChecker struct {
-	driver.Conn
-	driver.Queryer
 	driver.ConnPrepareContext
-	driver.NamedValueChecker
 }
 
-// [driver.ExecerContext driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.NamedValueChecker]
+type w_conn_Execer_ExecerContext_Queryer_NamedValueChecker struct {
 	driver.Conn
+	driver.Execer
 	driver.ExecerContext
-	driver.ConnPrepareContext
+	driver.Queryer
 	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.ExecerContext driver.NamedValueChecker]
-type w_conn_Execer_ExecerContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.QueryerContext driver.NamedValueChecker]
+type w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
+	driver.QueryerContext
 	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.ExecerContext driver.Queryer]
-type w_conn_Execer_ExecerContext_Queryer struct {
+// [driver.Execer driver.Queryer driver.QueryerContext driver.NamedValueChecker]
+type w_conn_Execer_Queryer_QueryerContext_NamedValueChecker struct {
 	driver.Conn
 	driver.Execer
-	driver.ExecerContext
 	driver.Queryer
+	driver.QueryerContext
+	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.Queryer driver.NamedValueChecker]
-type w_conn_Execer_Queryer_NamedValueChecker struct {
+// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.NamedValueChecker]
+type w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker struct {
 	driver.Conn
-	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
+	driver.QueryerContext
 	driver.NamedValueChecker
 }
 
-// [driver.ExecerContext driver.Queryer driver.NamedValueChecker]
-type w_conn_ExecerContext_Queryer_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
+	driver.Execer
 	driver.ExecerContext
-	driver.Queryer
+	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.ExecerContext driver.ConnPrepareContext]
-type w_conn_Execer_ExecerContext_ConnPrepareContext struct {
+// [driver.Execer driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
 	driver.Execer
-	driver.ExecerContext
+	driver.Queryer
 	driver.ConnPrepareContext
+	driver.NamedValueChecker
 }
 
-// [driver.Queryer driver.QueryerContext driver.NamedValueChecker]
-type w_conn_Queryer_QueryerContext_NamedValueChecker struct {
+// [driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
+	driver.ExecerContext
 	driver.Queryer
-	driver.QueryerContext
+	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.Execer driver.QueryerContext driver.NamedValueChecker]
-type w_conn_Execer_QueryerContext_NamedValueChecker struct {
+// [driver.Execer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
 	driver.Execer
 	driver.QueryerContext
+	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.ExecerContext driver.QueryerContext driver.NamedValueChecker]
-type w_conn_ExecerContext_QueryerContext_NamedValueChecker struct {
+// [driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
 	driver.ExecerContext
 	driver.QueryerContext
+	driver.ConnPrepareContext
 	driver.NamedValueChecker
 }
 
-// [driver.Queryer driver.ConnPrepareContext]
-type w_conn_Queryer_ConnPrepareContext struct {
+// [driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
 	driver.Conn
 	driver.Queryer
+	driver.QueryerContext
 	driver.ConnPrepareContext
+	driver.NamedValueChecker
 }
 
-// [driver.Queryer driver.NamedValueChecker]
-type w_conn_Queryer_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.Queryer driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_Queryer_ConnBeginTx struct {
 	driver.Conn
+	driver.Execer
+	driver.ExecerContext
 	driver.Queryer
-	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ExecerContext]
-type w_conn_Execer_ExecerContext struct {
+// [driver.Execer driver.ExecerContext driver.QueryerContext driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_QueryerContext_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.ExecerContext
+	driver.QueryerContext
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.Queryer]
-type w_conn_ExecerContext_Queryer struct {
+// [driver.Execer driver.Queryer driver.QueryerContext driver.ConnBeginTx]
+type w_conn_Execer_Queryer_QueryerContext_ConnBeginTx struct {
 	driver.Conn
-	driver.ExecerContext
+	driver.Execer
 	driver.Queryer
+	driver.QueryerContext
+	driver.ConnBeginTx
 }
 
-// [driver.QueryerContext driver.ConnPrepareContext]
-type w_conn_QueryerContext_ConnPrepareContext struct {
+// [driver.ExecerContext driver.Queryer driver.QueryerContext driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_QueryerContext_ConnBeginTx struct {
 	driver.Conn
+	driver.ExecerContext
+	driver.Queryer
 	driver.QueryerContext
-	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.ConnPrepareContext driver.NamedValueChecker]
-type w_conn_ConnPrepareContext_NamedValueChecker struct {
+// [driver.Execer driver.ExecerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
+	driver.Execer
+	driver.ExecerContext
 	driver.ConnPrepareContext
-	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.QueryerContext]
-type w_conn_Execer_QueryerContext struct {
+// [driver.Execer driver.Queryer driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_Queryer_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
-	driver.QueryerContext
+	driver.Queryer
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.NamedValueChecker]
-type w_conn_ExecerContext_NamedValueChecker struct {
+// [driver.ExecerContext driver.Queryer driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
 	driver.ExecerContext
-	driver.NamedValueChecker
+	driver.Queryer
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.QueryerContext]
-type w_conn_ExecerContext_QueryerContext struct {
+// [driver.Execer driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+}
+
+// [driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
 	driver.Conn
 	driver.ExecerContext
 	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.NamedValueChecker]
-type w_conn_Execer_NamedValueChecker struct {
+// [driver.Queryer driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
+	driver.Conn
+	driver.Queryer
+	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+}
+
+// [driver.Execer driver.ExecerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
+	driver.ExecerContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.Queryer]
-type w_conn_Execer_Queryer struct {
+// [driver.Execer driver.Queryer driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_Queryer_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
 	driver.Queryer
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.QueryerContext driver.NamedValueChecker]
-type w_conn_QueryerContext_NamedValueChecker struct {
+// [driver.ExecerContext driver.Queryer driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
-	driver.QueryerContext
+	driver.ExecerContext
+	driver.Queryer
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer driver.ConnPrepareContext]
-type w_conn_Execer_ConnPrepareContext struct {
+// [driver.Execer driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_QueryerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
-	driver.ConnPrepareContext
+	driver.QueryerContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext driver.ConnPrepareContext]
-type w_conn_ExecerContext_ConnPrepareContext struct {
+// [driver.ExecerContext driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.ExecerContext
-	driver.ConnPrepareContext
-}
-
-// [driver.Queryer driver.QueryerContext]
-type w_conn_Queryer_QueryerContext struct {
-	driver.Conn
-	driver.Queryer
 	driver.QueryerContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.QueryerContext]
-type w_conn_QueryerContext struct {
+// [driver.Queryer driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
+	driver.Queryer
 	driver.QueryerContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Execer]
-type w_conn_Execer struct {
+// [driver.Execer driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Execer
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.ConnPrepareContext]
-type w_conn_ConnPrepareContext struct {
+// [driver.ExecerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
+	driver.ExecerContext
 	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.Queryer]
-type w_conn_Queryer struct {
+// [driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
 	driver.Queryer
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.NamedValueChecker]
-type w_conn_NamedValueChecker struct {
+// [driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
 	driver.Conn
+	driver.QueryerContext
+	driver.ConnPrepareContext
 	driver.NamedValueChecker
+	driver.ConnBeginTx
 }
 
-// [driver.ExecerContext]
-type w_conn_ExecerContext struct {
+// [driver.Execer driver.ExecerContext driver.Queryer]
+type w_conn_Execer_ExecerContext_Queryer struct {
 	driver.Conn
+	driver.Execer
 	driver.ExecerContext
+	driver.Queryer
 }
 
-// [driver.StmtExecContext driver.StmtQueryContext driver.NamedValueChecker driver.ColumnConverter]
-type w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter struct {
-	driver.Stmt
-	driver.StmtExecContext
-	driver.StmtQueryContext
-	driver.NamedValueChecker
-	cc driver.ColumnConverter
+// [driver.Execer driver.ExecerContext driver.QueryerContext]
+type w_conn_Execer_ExecerContext_QueryerContext struct {
+	driver.Conn
+	driver.Execer
+	driver.ExecerContext
+	driver.QueryerContext
 }
 
-func (w *w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+// [driver.Execer driver.Queryer driver.QueryerContext]
+type w_conn_Execer_Queryer_QueryerContext struct {
+	driver.Conn
+	driver.Execer
+	driver.Queryer
+	driver.QueryerContext
 }
 
-// [driver.StmtExecContext driver.StmtQueryContext driver.NamedValueChecker]
-type w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker struct {
-	driver.Stmt
-	driver.StmtExecContext
-	driver.StmtQueryContext
-	driver.NamedValueChecker
+// [driver.ExecerContext driver.Queryer driver.QueryerContext]
+type w_conn_ExecerContext_Queryer_QueryerContext struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.Queryer
+	driver.QueryerContext
 }
 
-// [driver.StmtQueryContext driver.NamedValueChecker driver.ColumnConverter]
-type w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter struct {
-	driver.Stmt
-	driver.StmtQueryContext
-	driver.NamedValueChecker
-	cc driver.ColumnConverter
+// [driver.Execer driver.ExecerContext driver.ConnPrepareContext]
+type w_conn_Execer_ExecerContext_ConnPrepareContext struct {
+	driver.Conn
+	driver.Execer
+	driver.ExecerContext
+	driver.ConnPrepareContext
 }
 
-func (w *w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+// [driver.Execer driver.Queryer driver.ConnPrepareContext]
+type w_conn_Execer_Queryer_ConnPrepareContext struct {
+	driver.Conn
+	driver.Execer
+	driver.Queryer
+	driver.ConnPrepareContext
 }
 
-// [driver.StmtExecContext driver.NamedValueChecker driver.ColumnConverter]
-type w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter struct {
-	driver.Stmt
-	driver.StmtExecContext
-	driver.NamedValueChecker
-	cc driver.ColumnConverter
+// [driver.ExecerContext driver.Queryer driver.ConnPrepareContext]
+type w_conn_ExecerContext_Queryer_ConnPrepareContext struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.Queryer
+	driver.ConnPrepareContext
 }
 
-func (w *w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+// [driver.Execer driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_Execer_QueryerContext_ConnPrepareContext struct {
+	driver.Conn
+	driver.Execer
+	driver.QueryerContext
+	driver.ConnPrepareContext
 }
 
-// [driver.StmtExecContext driver.StmtQueryContext driver.ColumnConverter]
-type w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter struct {
-	driver.Stmt
-	driver.StmtExecContext
-	driver.StmtQueryContext
-	cc driver.ColumnConverter
+// [driver.ExecerContext driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_ExecerContext_QueryerContext_ConnPrepareContext struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.ConnPrepareContext
 }
 
-func (w *w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+// [driver.Queryer driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_Queryer_QueryerContext_ConnPrepareContext struct {
+	driver.Conn
+	driver.Queryer
+	driver.QueryerContext
+	driver.ConnPrepareContext
 }
 
-// [driver.StmtQueryContext driver.ColumnConverter]
-type w_stmt_StmtQueryContext_ColumnConverter struct {
-	driver.Stmt
-	driver.StmtQueryContext
-	cc driver.ColumnConverter
+// [driver.Execer driver.ExecerContext driver.NamedValueChecker]
+type w_conn_Execer_ExecerContext_NamedValueChecker struct {
+	driver.Conn
+	driver.Execer
+	driver.ExecerContext
+	driver.NamedValueChecker
 }
 
-func (w *w_stmt_StmtQueryContext_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+// [driver.Execer driver.Queryer driver.NamedValueChecker]
+type w_conn_Execer_Queryer_NamedValueChecker struct {
+	driver.Conn
+	driver.Execer
+	driver.Queryer
+	driver.NamedValueChecker
 }
 
-// [driver.StmtQueryContext driver.NamedValueChecker]
-type w_stmt_StmtQueryContext_NamedValueChecker struct {
-	driver.Stmt
-	driver.StmtQueryContext
+// [driver.ExecerContext driver.Queryer driver.NamedValueChecker]
+type w_conn_ExecerContext_Queryer_NamedValueChecker struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.Queryer
 	driver.NamedValueChecker
 }
 
-// [driver.StmtExecContext driver.ColumnConverter]
-type w_stmt_StmtExecContext_ColumnConverter struct {
-	driver.Stmt
-	driver.StmtExecContext
-	cc driver.ColumnConverter
+// [driver.Execer driver.QueryerContext driver.NamedValueChecker]
+type w_conn_Execer_QueryerContext_NamedValueChecker struct {
+	driver.Conn
+	driver.Execer
+	driver.QueryerContext
+	driver.NamedValueChecker
 }
 
-func (w *w_stmt_StmtExecContext_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+// [driver.ExecerContext driver.QueryerContext driver.NamedValueChecker]
+type w_conn_ExecerContext_QueryerContext_NamedValueChecker struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.NamedValueChecker
 }
 
-// [driver.StmtExecContext driver.NamedValueChecker]
-type w_stmt_StmtExecContext_NamedValueChecker struct {
-	driver.Stmt
-	driver.StmtExecContext
+// [driver.Queryer driver.QueryerContext driver.NamedValueChecker]
+type w_conn_Queryer_QueryerContext_NamedValueChecker struct {
+	driver.Conn
+	driver.Queryer
+	driver.QueryerContext
 	driver.NamedValueChecker
 }
 
-// [driver.NamedValueChecker driver.ColumnConverter]
-type w_stmt_NamedValueChecker_ColumnConverter struct {
-	driver.Stmt
+// [driver.Execer driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Execer_ConnPrepareContext_NamedValueChecker struct {
+	driver.Conn
+	driver.Execer
+	driver.ConnPrepareContext
 	driver.NamedValueChecker
-	cc driver.ColumnConverter
 }
 
-func (w *w_stmt_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+// [driver.ExecerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
 }
 
-// [driver.StmtExecContext driver.StmtQueryContext]
-type w_stmt_StmtExecContext_StmtQueryContext struct {
-	driver.Stmt
-	driver.StmtExecContext
-	driver.StmtQueryContext
+// [driver.Queryer driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_Queryer_ConnPrepareContext_NamedValueChecker struct {
+	driver.Conn
+	driver.Queryer
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
 }
 
-// [driver.ColumnConverter]
-type w_stmt_ColumnConverter struct {
+// [driver.QueryerContext driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker struct {
+	driver.Conn
+	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+}
+
+// [driver.Execer driver.ExecerContext driver.ConnBeginTx]
+type w_conn_Execer_ExecerContext_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.ExecerContext
+	driver.ConnBeginTx
+}
+
+// [driver.Execer driver.Queryer driver.ConnBeginTx]
+type w_conn_Execer_Queryer_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.Queryer
+	driver.ConnBeginTx
+}
+
+// [driver.ExecerContext driver.Queryer driver.ConnBeginTx]
+type w_conn_ExecerContext_Queryer_ConnBeginTx struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.Queryer
+	driver.ConnBeginTx
+}
+
+// [driver.Execer driver.QueryerContext driver.ConnBeginTx]
+type w_conn_Execer_QueryerContext_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.QueryerContext
+	driver.ConnBeginTx
+}
+
+// [driver.ExecerContext driver.QueryerContext driver.ConnBeginTx]
+type w_conn_ExecerContext_QueryerContext_ConnBeginTx struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.ConnBeginTx
+}
+
+// [driver.Queryer driver.QueryerContext driver.ConnBeginTx]
+type w_conn_Queryer_QueryerContext_ConnBeginTx struct {
+	driver.Conn
+	driver.Queryer
+	driver.QueryerContext
+	driver.ConnBeginTx
+}
+
+// [driver.Execer driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Execer_ConnPrepareContext_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+}
+
+// [driver.ExecerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_ExecerContext_ConnPrepareContext_ConnBeginTx struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+}
+
+// [driver.Queryer driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_Queryer_ConnPrepareContext_ConnBeginTx struct {
+	driver.Conn
+	driver.Queryer
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+}
+
+// [driver.QueryerContext driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_QueryerContext_ConnPrepareContext_ConnBeginTx struct {
+	driver.Conn
+	driver.QueryerContext
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+}
+
+// [driver.Execer driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Execer_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
+// [driver.ExecerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ExecerContext_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
+// [driver.Queryer driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_Queryer_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.Queryer
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
+// [driver.QueryerContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_QueryerContext_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.QueryerContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
+// [driver.ConnPrepareContext driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_ConnPrepareContext_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
+// [driver.Execer driver.ExecerContext]
+type w_conn_Execer_ExecerContext struct {
+	driver.Conn
+	driver.Execer
+	driver.ExecerContext
+}
+
+// [driver.Execer driver.Queryer]
+type w_conn_Execer_Queryer struct {
+	driver.Conn
+	driver.Execer
+	driver.Queryer
+}
+
+// [driver.ExecerContext driver.Queryer]
+type w_conn_ExecerContext_Queryer struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.Queryer
+}
+
+// [driver.Execer driver.QueryerContext]
+type w_conn_Execer_QueryerContext struct {
+	driver.Conn
+	driver.Execer
+	driver.QueryerContext
+}
+
+// [driver.ExecerContext driver.QueryerContext]
+type w_conn_ExecerContext_QueryerContext struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.QueryerContext
+}
+
+// [driver.Queryer driver.QueryerContext]
+type w_conn_Queryer_QueryerContext struct {
+	driver.Conn
+	driver.Queryer
+	driver.QueryerContext
+}
+
+// [driver.Execer driver.ConnPrepareContext]
+type w_conn_Execer_ConnPrepareContext struct {
+	driver.Conn
+	driver.Execer
+	driver.ConnPrepareContext
+}
+
+// [driver.ExecerContext driver.ConnPrepareContext]
+type w_conn_ExecerContext_ConnPrepareContext struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.ConnPrepareContext
+}
+
+// [driver.Queryer driver.ConnPrepareContext]
+type w_conn_Queryer_ConnPrepareContext struct {
+	driver.Conn
+	driver.Queryer
+	driver.ConnPrepareContext
+}
+
+// [driver.QueryerContext driver.ConnPrepareContext]
+type w_conn_QueryerContext_ConnPrepareContext struct {
+	driver.Conn
+	driver.QueryerContext
+	driver.ConnPrepareContext
+}
+
+// [driver.Execer driver.NamedValueChecker]
+type w_conn_Execer_NamedValueChecker struct {
+	driver.Conn
+	driver.Execer
+	driver.NamedValueChecker
+}
+
+// [driver.ExecerContext driver.NamedValueChecker]
+type w_conn_ExecerContext_NamedValueChecker struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.NamedValueChecker
+}
+
+// [driver.Queryer driver.NamedValueChecker]
+type w_conn_Queryer_NamedValueChecker struct {
+	driver.Conn
+	driver.Queryer
+	driver.NamedValueChecker
+}
+
+// [driver.QueryerContext driver.NamedValueChecker]
+type w_conn_QueryerContext_NamedValueChecker struct {
+	driver.Conn
+	driver.QueryerContext
+	driver.NamedValueChecker
+}
+
+// [driver.ConnPrepareContext driver.NamedValueChecker]
+type w_conn_ConnPrepareContext_NamedValueChecker struct {
+	driver.Conn
+	driver.ConnPrepareContext
+	driver.NamedValueChecker
+}
+
+// [driver.Execer driver.ConnBeginTx]
+type w_conn_Execer_ConnBeginTx struct {
+	driver.Conn
+	driver.Execer
+	driver.ConnBeginTx
+}
+
+// [driver.ExecerContext driver.ConnBeginTx]
+type w_conn_ExecerContext_ConnBeginTx struct {
+	driver.Conn
+	driver.ExecerContext
+	driver.ConnBeginTx
+}
+
+// [driver.Queryer driver.ConnBeginTx]
+type w_conn_Queryer_ConnBeginTx struct {
+	driver.Conn
+	driver.Queryer
+	driver.ConnBeginTx
+}
+
+// [driver.QueryerContext driver.ConnBeginTx]
+type w_conn_QueryerContext_ConnBeginTx struct {
+	driver.Conn
+	driver.QueryerContext
+	driver.ConnBeginTx
+}
+
+// [driver.ConnPrepareContext driver.ConnBeginTx]
+type w_conn_ConnPrepareContext_ConnBeginTx struct {
+	driver.Conn
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+}
+
+// [driver.NamedValueChecker driver.ConnBeginTx]
+type w_conn_NamedValueChecker_ConnBeginTx struct {
+	driver.Conn
+	driver.NamedValueChecker
+	driver.ConnBeginTx
+}
+
+// [driver.Execer]
+type w_conn_Execer struct {
+	driver.Conn
+	driver.Execer
+}
+
+// [driver.ExecerContext]
+type w_conn_ExecerContext struct {
+	driver.Conn
+	driver.ExecerContext
+}
+
+// [driver.Queryer]
+type w_conn_Queryer struct {
+	driver.Conn
+	driver.Queryer
+}
+
+// [driver.QueryerContext]
+type w_conn_QueryerContext struct {
+	driver.Conn
+	driver.QueryerContext
+}
+
+// [driver.ConnPrepareContext]
+type w_conn_ConnPrepareContext struct {
+	driver.Conn
+	driver.ConnPrepareContext
+}
+
+// [driver.NamedValueChecker]
+type w_conn_NamedValueChecker struct {
+	driver.Conn
+	driver.NamedValueChecker
+}
+
+// [driver.ConnBeginTx]
+type w_conn_ConnBeginTx struct {
+	driver.Conn
+	driver.ConnBeginTx
+}
+
+// [driver.StmtExecContext driver.StmtQueryContext driver.NamedValueChecker driver.ColumnConverter]
+type w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter struct {
 	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+	driver.NamedValueChecker
 	cc driver.ColumnConverter
 }
 
-func (w *w_stmt_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
-	return w.cc.ColumnConverter(idx)
+func (w *w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+}
+
+// [driver.StmtExecContext driver.StmtQueryContext driver.NamedValueChecker]
+type w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker struct {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+	driver.NamedValueChecker
+}
+
+// [driver.StmtExecContext driver.StmtQueryContext driver.ColumnConverter]
+type w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter struct {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+	cc driver.ColumnConverter
+}
+
+func (w *w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+}
+
+// [driver.StmtExecContext driver.NamedValueChecker driver.ColumnConverter]
+type w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter struct {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.NamedValueChecker
+	cc driver.ColumnConverter
+}
+
+func (w *w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+}
+
+// [driver.StmtQueryContext driver.NamedValueChecker driver.ColumnConverter]
+type w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter struct {
+	driver.Stmt
+	driver.StmtQueryContext
+	driver.NamedValueChecker
+	cc driver.ColumnConverter
+}
+
+func (w *w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+}
+
+// [driver.StmtExecContext driver.StmtQueryContext]
+type w_stmt_StmtExecContext_StmtQueryContext struct {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+}
+
+// [driver.StmtExecContext driver.NamedValueChecker]
+type w_stmt_StmtExecContext_NamedValueChecker struct {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.NamedValueChecker
+}
+
+// [driver.StmtQueryContext driver.NamedValueChecker]
+type w_stmt_StmtQueryContext_NamedValueChecker struct {
+	driver.Stmt
+	driver.StmtQueryContext
+	driver.NamedValueChecker
+}
+
+// [driver.StmtExecContext driver.ColumnConverter]
+type w_stmt_StmtExecContext_ColumnConverter struct {
+	driver.Stmt
+	driver.StmtExecContext
+	cc driver.ColumnConverter
+}
+
+func (w *w_stmt_StmtExecContext_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+}
+
+// [driver.StmtQueryContext driver.ColumnConverter]
+type w_stmt_StmtQueryContext_ColumnConverter struct {
+	driver.Stmt
+	driver.StmtQueryContext
+	cc driver.ColumnConverter
+}
+
+func (w *w_stmt_StmtQueryContext_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+}
+
+// [driver.NamedValueChecker driver.ColumnConverter]
+type w_stmt_NamedValueChecker_ColumnConverter struct {
+	driver.Stmt
+	driver.NamedValueChecker
+	cc driver.ColumnConverter
+}
+
+func (w *w_stmt_NamedValueChecker_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+}
+
+// [driver.StmtExecContext]
+type w_stmt_StmtExecContext struct {
+	driver.Stmt
+	driver.StmtExecContext
+}
+
+// [driver.StmtQueryContext]
+type w_stmt_StmtQueryContext struct {
+	driver.Stmt
+	driver.StmtQueryContext
+}
+
+// [driver.NamedValueChecker]
+type w_stmt_NamedValueChecker struct {
+	driver.Stmt
+	driver.NamedValueChecker
+}
+
+// [driver.ColumnConverter]
+type w_stmt_ColumnConverter struct {
+	driver.Stmt
+	cc driver.ColumnConverter
+}
+
+func (w *w_stmt_ColumnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return w.cc.ColumnConverter(idx)
+} // connAlreadyWrapped returns true if conn is already instrumented
+func connAlreadyWrapped(conn driver.Conn) bool {
+	switch conn.(type) {
+	case *wConn, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer_QueryerContext, *w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext, *w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext, *w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext, *w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, *w_conn_Execer_ExecerContext_Queryer_NamedValueChecker, *w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker, *w_conn_Execer_Queryer_QueryerContext_NamedValueChecker, *w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker, *w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_ConnBeginTx, *w_conn_Execer_ExecerContext_QueryerContext_ConnBeginTx, *w_conn_Execer_Queryer_QueryerContext_ConnBeginTx, *w_conn_ExecerContext_Queryer_QueryerContext_ConnBeginTx, *w_conn_Execer_ExecerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_Queryer_ConnPrepareContext_ConnBeginTx, *w_conn_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_ExecerContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_Queryer_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx, *w_conn_Execer_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext_Queryer, *w_conn_Execer_ExecerContext_QueryerContext, *w_conn_Execer_Queryer_QueryerContext, *w_conn_ExecerContext_Queryer_QueryerContext, *w_conn_Execer_ExecerContext_ConnPrepareContext, *w_conn_Execer_Queryer_ConnPrepareContext, *w_conn_ExecerContext_Queryer_ConnPrepareContext, *w_conn_Execer_QueryerContext_ConnPrepareContext, *w_conn_ExecerContext_QueryerContext_ConnPrepareContext, *w_conn_Queryer_QueryerContext_ConnPrepareContext, *w_conn_Execer_ExecerContext_NamedValueChecker, *w_conn_Execer_Queryer_NamedValueChecker, *w_conn_ExecerContext_Queryer_NamedValueChecker, *w_conn_Execer_QueryerContext_NamedValueChecker, *w_conn_ExecerContext_QueryerContext_NamedValueChecker, *w_conn_Queryer_QueryerContext_NamedValueChecker, *w_conn_Execer_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_ConnBeginTx, *w_conn_Execer_Queryer_ConnBeginTx, *w_conn_ExecerContext_Queryer_ConnBeginTx, *w_conn_Execer_QueryerContext_ConnBeginTx, *w_conn_ExecerContext_QueryerContext_ConnBeginTx, *w_conn_Queryer_QueryerContext_ConnBeginTx, *w_conn_Execer_ConnPrepareContext_ConnBeginTx, *w_conn_ExecerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Queryer_ConnPrepareContext_ConnBeginTx, *w_conn_QueryerContext_ConnPrepareContext_ConnBeginTx, *w_conn_Execer_NamedValueChecker_ConnBeginTx, *w_conn_ExecerContext_NamedValueChecker_ConnBeginTx, *w_conn_Queryer_NamedValueChecker_ConnBeginTx, *w_conn_QueryerContext_NamedValueChecker_ConnBeginTx, *w_conn_ConnPrepareContext_NamedValueChecker_ConnBeginTx, *w_conn_Execer_ExecerContext, *w_conn_Execer_Queryer, *w_conn_ExecerContext_Queryer, *w_conn_Execer_QueryerContext, *w_conn_ExecerContext_QueryerContext, *w_conn_Queryer_QueryerContext, *w_conn_Execer_ConnPrepareContext, *w_conn_ExecerContext_ConnPrepareContext, *w_conn_Queryer_ConnPrepareContext, *w_conn_QueryerContext_ConnPrepareContext, *w_conn_Execer_NamedValueChecker, *w_conn_ExecerContext_NamedValueChecker, *w_conn_Queryer_NamedValueChecker, *w_conn_QueryerContext_NamedValueChecker, *w_conn_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ConnBeginTx, *w_conn_ExecerContext_ConnBeginTx, *w_conn_Queryer_ConnBeginTx, *w_conn_QueryerContext_ConnBeginTx, *w_conn_ConnPrepareContext_ConnBeginTx, *w_conn_NamedValueChecker_ConnBeginTx, *w_conn_Execer, *w_conn_ExecerContext, *w_conn_Queryer, *w_conn_QueryerContext, *w_conn_ConnPrepareContext, *w_conn_NamedValueChecker, *w_conn_ConnBeginTx:
+		return true
+	case w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx, w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer_QueryerContext, w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext, w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext, w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext, w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, w_conn_Execer_ExecerContext_Queryer_NamedValueChecker, w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker, w_conn_Execer_Queryer_QueryerContext_NamedValueChecker, w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker, w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_ConnBeginTx, w_conn_Execer_ExecerContext_QueryerContext_ConnBeginTx, w_conn_Execer_Queryer_QueryerContext_ConnBeginTx, w_conn_ExecerContext_Queryer_QueryerContext_ConnBeginTx, w_conn_Execer_ExecerContext_ConnPrepareContext_ConnBeginTx, w_conn_Execer_Queryer_ConnPrepareContext_ConnBeginTx, w_conn_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx, w_conn_Execer_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_Execer_ExecerContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_Queryer_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx, w_conn_Execer_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext_Queryer, w_conn_Execer_ExecerContext_QueryerContext, w_conn_Execer_Queryer_QueryerContext, w_conn_ExecerContext_Queryer_QueryerContext, w_conn_Execer_ExecerContext_ConnPrepareContext, w_conn_Execer_Queryer_ConnPrepareContext, w_conn_ExecerContext_Queryer_ConnPrepareContext, w_conn_Execer_QueryerContext_ConnPrepareContext, w_conn_ExecerContext_QueryerContext_ConnPrepareContext, w_conn_Queryer_QueryerContext_ConnPrepareContext, w_conn_Execer_ExecerContext_NamedValueChecker, w_conn_Execer_Queryer_NamedValueChecker, w_conn_ExecerContext_Queryer_NamedValueChecker, w_conn_Execer_QueryerContext_NamedValueChecker, w_conn_ExecerContext_QueryerContext_NamedValueChecker, w_conn_Queryer_QueryerContext_NamedValueChecker, w_conn_Execer_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker, w_conn_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_ConnBeginTx, w_conn_Execer_Queryer_ConnBeginTx, w_conn_ExecerContext_Queryer_ConnBeginTx, w_conn_Execer_QueryerContext_ConnBeginTx, w_conn_ExecerContext_QueryerContext_ConnBeginTx, w_conn_Queryer_QueryerContext_ConnBeginTx, w_conn_Execer_ConnPrepareContext_ConnBeginTx, w_conn_ExecerContext_ConnPrepareContext_ConnBeginTx, w_conn_Queryer_ConnPrepareContext_ConnBeginTx, w_conn_QueryerContext_ConnPrepareContext_ConnBeginTx, w_conn_Execer_NamedValueChecker_ConnBeginTx, w_conn_ExecerContext_NamedValueChecker_ConnBeginTx, w_conn_Queryer_NamedValueChecker_ConnBeginTx, w_conn_QueryerContext_NamedValueChecker_ConnBeginTx, w_conn_ConnPrepareContext_NamedValueChecker_ConnBeginTx, w_conn_Execer_ExecerContext, w_conn_Execer_Queryer, w_conn_ExecerContext_Queryer, w_conn_Execer_QueryerContext, w_conn_ExecerContext_QueryerContext, w_conn_Queryer_QueryerContext, w_conn_Execer_ConnPrepareContext, w_conn_ExecerContext_ConnPrepareContext, w_conn_Queryer_ConnPrepareContext, w_conn_QueryerContext_ConnPrepareContext, w_conn_Execer_NamedValueChecker, w_conn_ExecerContext_NamedValueChecker, w_conn_Queryer_NamedValueChecker, w_conn_QueryerContext_NamedValueChecker, w_conn_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ConnBeginTx, w_conn_ExecerContext_ConnBeginTx, w_conn_Queryer_ConnBeginTx, w_conn_QueryerContext_ConnBeginTx, w_conn_ConnPrepareContext_ConnBeginTx, w_conn_NamedValueChecker_ConnBeginTx, w_conn_Execer, w_conn_ExecerContext, w_conn_Queryer, w_conn_QueryerContext, w_conn_ConnPrepareContext, w_conn_NamedValueChecker, w_conn_ConnBeginTx:
+		return true
+	}
+	return false
+}
+
+// wrapConn wraps the matching type around the driver.Conn based on which interfaces the driver implements
+func wrapConn(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger) driver.Conn {
+	// the transaction state is shared by all wrappers created for this connection
+	connDetails.tx = &sqlTxState{}
+
+	Execer, isExecer := conn.(driver.Execer)
+	ExecerContext, isExecerContext := conn.(driver.ExecerContext)
+	Queryer, isQueryer := conn.(driver.Queryer)
+	QueryerContext, isQueryerContext := conn.(driver.QueryerContext)
+	ConnPrepareContext, isConnPrepareContext := conn.(driver.ConnPrepareContext)
+	NamedValueChecker, isNamedValueChecker := conn.(driver.NamedValueChecker)
+	ConnBeginTx, isConnBeginTx := conn.(driver.ConnBeginTx)
+
+	if f, ok := _conn_n[convertBooleansToInt(isExecer, isExecerContext, isQueryer, isQueryerContext, isConnPrepareContext, isNamedValueChecker, isConnBeginTx)]; ok {
+		return f(connDetails, conn, sensor, Execer, ExecerContext, Queryer, QueryerContext, ConnPrepareContext, NamedValueChecker, ConnBeginTx)
+	}
+
+	return &wConn{
+		Conn:        conn,
+		connDetails: connDetails,
+		sensor:      sensor,
+	}
+}
+
+// driver.Conn Constructors
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker}
+}
+
+func get_conn_Execer_ExecerContext_Queryer_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_Queryer_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
+
+func get_conn_Execer_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-// [driver.StmtExecContext]
-type w_stmt_StmtExecContext struct {
-	driver.Stmt
-	driver.StmtExecContext
+func get_conn_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-// [driver.NamedValueChecker]
-type w_stmt_NamedValueChecker struct {
-	driver.Stmt
-	driver.NamedValueChecker
+func get_conn_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-// [driver.StmtQueryContext]
-type w_stmt_StmtQueryContext struct {
-	driver.Stmt
-	driver.StmtQueryContext
+func get_conn_Execer_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-// connAlreadyWrapped returns true if conn is already instrumented
-func connAlreadyWrapped(conn driver.Conn) bool {
-	switch conn.(type) {
-	case *wConn, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, *w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker, *w_conn_Execer_Queryer_QueryerContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext, *w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_QueryerContext, *w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker, *w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer_NamedValueChecker, *w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, *w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext, *w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext, *w_conn_Execer_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_Queryer_QueryerContext, *w_conn_Execer_QueryerContext_ConnPrepareContext, *w_conn_ExecerContext_QueryerContext_ConnPrepareContext, *w_conn_ExecerContext_Queryer_ConnPrepareContext, *w_conn_Queryer_QueryerContext_ConnPrepareContext, *w_conn_Execer_Queryer_ConnPrepareContext, *w_conn_Execer_ExecerContext_QueryerContext, *w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_Queryer_QueryerContext, *w_conn_Queryer_ConnPrepareContext_NamedValueChecker, *w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_ExecerContext_NamedValueChecker, *w_conn_Execer_ExecerContext_Queryer, *w_conn_Execer_Queryer_NamedValueChecker, *w_conn_ExecerContext_Queryer_NamedValueChecker, *w_conn_Execer_ExecerContext_ConnPrepareContext, *w_conn_Queryer_QueryerContext_NamedValueChecker, *w_conn_Execer_QueryerContext_NamedValueChecker, *w_conn_ExecerContext_QueryerContext_NamedValueChecker, *w_conn_Queryer_ConnPrepareContext, *w_conn_Queryer_NamedValueChecker, *w_conn_Execer_ExecerContext, *w_conn_ExecerContext_Queryer, *w_conn_QueryerContext_ConnPrepareContext, *w_conn_ConnPrepareContext_NamedValueChecker, *w_conn_Execer_QueryerContext, *w_conn_ExecerContext_NamedValueChecker, *w_conn_ExecerContext_QueryerContext, *w_conn_Execer_NamedValueChecker, *w_conn_Execer_Queryer, *w_conn_QueryerContext_NamedValueChecker, *w_conn_Execer_ConnPrepareContext, *w_conn_ExecerContext_ConnPrepareContext, *w_conn_Queryer_QueryerContext, *w_conn_QueryerContext, *w_conn_Execer, *w_conn_ConnPrepareContext, *w_conn_Queryer, *w_conn_NamedValueChecker, *w_conn_ExecerContext:
-		return true
-	case w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker, w_conn_Execer_Queryer_QueryerContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext, w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_QueryerContext, w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker, w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer_NamedValueChecker, w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext, w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext, w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext, w_conn_Execer_ConnPrepareContext_NamedValueChecker, w_conn_Execer_Queryer_QueryerContext, w_conn_Execer_QueryerContext_ConnPrepareContext, w_conn_ExecerContext_QueryerContext_ConnPrepareContext, w_conn_ExecerContext_Queryer_ConnPrepareContext, w_conn_Queryer_QueryerContext_ConnPrepareContext, w_conn_Execer_Queryer_ConnPrepareContext, w_conn_Execer_ExecerContext_QueryerContext, w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_Queryer_QueryerContext, w_conn_Queryer_ConnPrepareContext_NamedValueChecker, w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker, w_conn_Execer_ExecerContext_NamedValueChecker, w_conn_Execer_ExecerContext_Queryer, w_conn_Execer_Queryer_NamedValueChecker, w_conn_ExecerContext_Queryer_NamedValueChecker, w_conn_Execer_ExecerContext_ConnPrepareContext, w_conn_Queryer_QueryerContext_NamedValueChecker, w_conn_Execer_QueryerContext_NamedValueChecker, w_conn_ExecerContext_QueryerContext_NamedValueChecker, w_conn_Queryer_ConnPrepareContext, w_conn_Queryer_NamedValueChecker, w_conn_Execer_ExecerContext, w_conn_ExecerContext_Queryer, w_conn_QueryerContext_ConnPrepareContext, w_conn_ConnPrepareContext_NamedValueChecker, w_conn_Execer_QueryerContext, w_conn_ExecerContext_NamedValueChecker, w_conn_ExecerContext_QueryerContext, w_conn_Execer_NamedValueChecker, w_conn_Execer_Queryer, w_conn_QueryerContext_NamedValueChecker, w_conn_Execer_ConnPrepareContext, w_conn_ExecerContext_ConnPrepareContext, w_conn_Queryer_QueryerContext, w_conn_QueryerContext, w_conn_Execer, w_conn_ConnPrepareContext, w_conn_Queryer, w_conn_NamedValueChecker, w_conn_ExecerContext:
-		return true
-	}
-	return false
+func get_conn_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-// wrapConn wraps the matching type around the driver.Conn based on which interfaces the driver implements
-func wrapConn(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger) driver.Conn {
-	Execer, isExecer := conn.(driver.Execer)
-	ExecerContext, isExecerContext := conn.(driver.ExecerContext)
-	Queryer, isQueryer := conn.(driver.Queryer)
-	QueryerContext, isQueryerContext := conn.(driver.QueryerContext)
-	ConnPrepareContext, isConnPrepareContext := conn.(driver.ConnPrepareContext)
-	NamedValueChecker, isNamedValueChecker := conn.(driver.NamedValueChecker)
+func get_conn_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
 
-	if f, ok := _conn_n[convertBooleansToInt(isExecer, isExecerContext, isQueryer, isQueryerContext, isConnPrepareContext, isNamedValueChecker)]; ok {
-		return f(connDetails, conn, sensor, Execer, ExecerContext, Queryer, QueryerContext, ConnPrepareContext, NamedValueChecker)
-	}
+func get_conn_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
+}
 
-	return &wConn{
-		Conn:        conn,
-		connDetails: connDetails,
-		sensor:      sensor,
-	}
+func get_conn_Execer_ExecerContext_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_Queryer{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		}}
 }
 
-// driver.Conn Constructors
+func get_conn_Execer_ExecerContext_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_QueryerContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}}
+}
 
-func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_Execer_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_QueryerContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -698,6 +3160,25 @@ func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_Nam
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}}
+}
+
+func get_conn_ExecerContext_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_QueryerContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
 		ExecerContext: &wExecerContext{
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
@@ -712,16 +3193,35 @@ func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_Nam
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
+		}}
+}
+
+func get_conn_Execer_ExecerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_ConnPrepareContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		Execer: &wExecer{
+			Execer:  Execer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
+		},
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
 		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext{
+func get_conn_Execer_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -732,21 +3232,11 @@ func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext(con
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
@@ -754,8 +3244,8 @@ func get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext(con
 		}}
 }
 
-func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_ExecerContext_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -771,20 +3261,15 @@ func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValue
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_Execer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -795,11 +3280,6 @@ func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		QueryerContext: &wQueryerContext{
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
@@ -809,21 +3289,16 @@ func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_ExecerContext_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		ExecerContext: &wExecerContext{
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
@@ -838,40 +3313,35 @@ func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueC
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker{
+func get_conn_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker{
+func get_conn_Execer_ExecerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -886,21 +3356,11 @@ func get_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker(conn
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
-		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer_QueryerContext_NamedValueChecker{
+func get_conn_Execer_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -915,26 +3375,16 @@ func get_conn_Execer_Queryer_QueryerContext_NamedValueChecker(connDetails DbConn
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer_ConnPrepareContext{
+func get_conn_ExecerContext_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		ExecerContext: &wExecerContext{
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
@@ -944,23 +3394,18 @@ func get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext(connDetails DbConn
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
-		}}
+		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_Execer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
+		Execer: &wExecer{
+			Execer:  Execer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
@@ -968,16 +3413,11 @@ func get_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDe
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_ExecerContext_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -992,23 +3432,18 @@ func get_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker(
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
+		Queryer: &wQueryer{
+			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
@@ -1016,28 +3451,18 @@ func get_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker(connDet
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker{
+func get_conn_Execer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ConnPrepareContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
+		Execer: &wExecer{
+			Execer:  Execer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
@@ -1048,22 +3473,17 @@ func get_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker(connDet
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker{
+func get_conn_ExecerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
+		ExecerContext: &wExecerContext{
+			ExecerContext: ExecerContext,
+			sqlSpan:       getSQLSpanData(connDetails),
+			sensor:        sensor,
 		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
@@ -1072,52 +3492,18 @@ func get_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker(connDetails Db
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_ExecerContext_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer_QueryerContext{
+func get_conn_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_ConnPrepareContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		}}
-}
-
-func get_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker{
-		Conn: &wConn{
-			Conn:        conn,
-			connDetails: connDetails,
-			sensor:      sensor,
-		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
@@ -1125,32 +3511,27 @@ func get_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker(connDeta
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker{
+func get_conn_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		QueryerContext: &wQueryerContext{
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker{
+func get_conn_Execer_ExecerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1166,15 +3547,15 @@ func get_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker(connDetails
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-func get_conn_Execer_ExecerContext_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer_NamedValueChecker{
+func get_conn_Execer_Queryer_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1185,20 +3566,20 @@ func get_conn_Execer_ExecerContext_Queryer_NamedValueChecker(connDetails DbConnD
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext{
+func get_conn_ExecerContext_Queryer_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1214,20 +3595,15 @@ func get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext(connDetail
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer_QueryerContext_ConnPrepareContext{
+func get_conn_Execer_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1238,34 +3614,24 @@ func get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext(connDetails DbCon
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		QueryerContext: &wQueryerContext{
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
 		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext{
-		Conn: &wConn{
-			Conn:        conn,
-			connDetails: connDetails,
-			sensor:      sensor,
-		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
+func get_conn_ExecerContext_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext_ConnBeginTx{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
 		},
 		ExecerContext: &wExecerContext{
 			ExecerContext: ExecerContext,
@@ -1277,44 +3643,20 @@ func get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext(connDetails
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
 		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
-		}}
-}
-
-func get_conn_Execer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ConnPrepareContext_NamedValueChecker{
-		Conn: &wConn{
-			Conn:        conn,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
 			connDetails: connDetails,
 			sensor:      sensor,
-		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer_QueryerContext{
+func get_conn_Queryer_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
@@ -1324,11 +3666,16 @@ func get_conn_Execer_Queryer_QueryerContext(connDetails DbConnDetails, conn driv
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Execer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_QueryerContext_ConnPrepareContext{
+func get_conn_Execer_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ConnPrepareContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1339,20 +3686,20 @@ func get_conn_Execer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_ExecerContext_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_QueryerContext_ConnPrepareContext{
+func get_conn_ExecerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_ConnPrepareContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1363,30 +3710,25 @@ func get_conn_ExecerContext_QueryerContext_ConnPrepareContext(connDetails DbConn
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_ExecerContext_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer_ConnPrepareContext{
+func get_conn_Queryer_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_ConnPrepareContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
@@ -1396,21 +3738,21 @@ func get_conn_ExecerContext_Queryer_ConnPrepareContext(connDetails DbConnDetails
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Queryer_QueryerContext_ConnPrepareContext{
+func get_conn_QueryerContext_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_QueryerContext_ConnPrepareContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		QueryerContext: &wQueryerContext{
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
@@ -1420,11 +3762,16 @@ func get_conn_Queryer_QueryerContext_ConnPrepareContext(connDetails DbConnDetail
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Execer_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer_ConnPrepareContext{
+func get_conn_Execer_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_NamedValueChecker_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1434,145 +3781,92 @@ func get_conn_Execer_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn
 			Execer:  Execer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Execer_ExecerContext_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_QueryerContext{
+func get_conn_ExecerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_NamedValueChecker_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		ExecerContext: &wExecerContext{
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
-		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		}}
-}
-
-func get_conn_QueryerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_QueryerContext_ConnPrepareContext_NamedValueChecker{
-		Conn: &wConn{
-			Conn:        conn,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
 			connDetails: connDetails,
 			sensor:      sensor,
-		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_ExecerContext_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer_QueryerContext{
+func get_conn_Queryer_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_NamedValueChecker_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Queryer_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Queryer_ConnPrepareContext_NamedValueChecker{
+func get_conn_QueryerContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_QueryerContext_NamedValueChecker_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-func get_conn_ExecerContext_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_ConnPrepareContext_NamedValueChecker{
+func get_conn_ConnPrepareContext_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ConnPrepareContext_NamedValueChecker_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		ConnPrepareContext: &wConnPrepareContext{
 			ConnPrepareContext: ConnPrepareContext,
 			connDetails:        connDetails,
 			sensor:             sensor,
-		}, NamedValueChecker: NamedValueChecker}
-}
-
-func get_conn_Execer_ExecerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_NamedValueChecker{
-		Conn: &wConn{
-			Conn:        conn,
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
 			connDetails: connDetails,
 			sensor:      sensor,
-		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_ExecerContext_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_Queryer{
+func get_conn_Execer_ExecerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ExecerContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1587,16 +3881,11 @@ func get_conn_Execer_ExecerContext_Queryer(connDetails DbConnDetails, conn drive
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
-		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
 		}}
 }
 
-func get_conn_Execer_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer_NamedValueChecker{
+func get_conn_Execer_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_Queryer{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1611,11 +3900,11 @@ func get_conn_Execer_Queryer_NamedValueChecker(connDetails DbConnDetails, conn d
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_ExecerContext_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer_NamedValueChecker{
+func get_conn_ExecerContext_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_Queryer{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1630,11 +3919,11 @@ func get_conn_ExecerContext_Queryer_NamedValueChecker(connDetails DbConnDetails,
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_ExecerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext_ConnPrepareContext{
+func get_conn_Execer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_QueryerContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1645,20 +3934,34 @@ func get_conn_Execer_ExecerContext_ConnPrepareContext(connDetails DbConnDetails,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		}}
+}
+
+func get_conn_ExecerContext_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_QueryerContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
 		ExecerContext: &wExecerContext{
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
 		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
 		}}
 }
 
-func get_conn_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Queryer_QueryerContext_NamedValueChecker{
+func get_conn_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_QueryerContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1673,11 +3976,11 @@ func get_conn_Queryer_QueryerContext_NamedValueChecker(connDetails DbConnDetails
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}}
 }
 
-func get_conn_Execer_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_QueryerContext_NamedValueChecker{
+func get_conn_Execer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1688,15 +3991,15 @@ func get_conn_Execer_QueryerContext_NamedValueChecker(connDetails DbConnDetails,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
 }
 
-func get_conn_ExecerContext_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_QueryerContext_NamedValueChecker{
+func get_conn_ExecerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1707,14 +4010,14 @@ func get_conn_ExecerContext_QueryerContext_NamedValueChecker(connDetails DbConnD
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
 }
 
-func get_conn_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
+func get_conn_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
 	return &w_conn_Queryer_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
@@ -1733,60 +4036,69 @@ func get_conn_Queryer_ConnPrepareContext(connDetails DbConnDetails, conn driver.
 		}}
 }
 
-func get_conn_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Queryer_NamedValueChecker{
+func get_conn_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_QueryerContext_ConnPrepareContext{
+		Conn: &wConn{
+			Conn:        conn,
+			connDetails: connDetails,
+			sensor:      sensor,
+		},
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
+		}}
+}
+
+func get_conn_Execer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
+		Execer: &wExecer{
+			Execer:  Execer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_ExecerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ExecerContext{
+func get_conn_ExecerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		},
 		ExecerContext: &wExecerContext{
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
-		}}
+		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_ExecerContext_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_Queryer{
+func get_conn_Queryer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
-		},
 		Queryer: &wQueryer{
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		}}
+		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_QueryerContext_ConnPrepareContext{
+func get_conn_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_QueryerContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1796,15 +4108,10 @@ func get_conn_QueryerContext_ConnPrepareContext(connDetails DbConnDetails, conn
 			QueryerContext: QueryerContext,
 			sqlSpan:        getSQLSpanData(connDetails),
 			sensor:         sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
-		}}
+		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
+func get_conn_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
 	return &w_conn_ConnPrepareContext_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
@@ -1818,8 +4125,8 @@ func get_conn_ConnPrepareContext_NamedValueChecker(connDetails DbConnDetails, co
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_Execer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_QueryerContext{
+func get_conn_Execer_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1830,15 +4137,15 @@ func get_conn_Execer_QueryerContext(connDetails DbConnDetails, conn driver.Conn,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_ExecerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_NamedValueChecker{
+func get_conn_ExecerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1848,77 +4155,87 @@ func get_conn_ExecerContext_NamedValueChecker(connDetails DbConnDetails, conn dr
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-func get_conn_ExecerContext_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_QueryerContext{
+func get_conn_Queryer_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
+		Queryer: &wQueryer{
+			Queryer: Queryer,
+			sqlSpan: getSQLSpanData(connDetails),
+			sensor:  sensor,
 		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_Execer_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_NamedValueChecker{
+func get_conn_QueryerContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_QueryerContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		QueryerContext: &wQueryerContext{
+			QueryerContext: QueryerContext,
+			sqlSpan:        getSQLSpanData(connDetails),
+			sensor:         sensor,
+		},
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-func get_conn_Execer_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_Queryer{
+func get_conn_ConnPrepareContext_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ConnPrepareContext_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
+		ConnPrepareContext: &wConnPrepareContext{
+			ConnPrepareContext: ConnPrepareContext,
+			connDetails:        connDetails,
+			sensor:             sensor,
 		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
-func get_conn_QueryerContext_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_QueryerContext_NamedValueChecker{
+func get_conn_NamedValueChecker_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_NamedValueChecker_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
-		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
-		}, NamedValueChecker: NamedValueChecker}
+		}, NamedValueChecker: NamedValueChecker,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
+		}}
 }
 
-func get_conn_Execer_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer_ConnPrepareContext{
+func get_conn_Execer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Execer{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1928,16 +4245,11 @@ func get_conn_Execer_ConnPrepareContext(connDetails DbConnDetails, conn driver.C
 			Execer:  Execer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
 		}}
 }
 
-func get_conn_ExecerContext_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext_ConnPrepareContext{
+func get_conn_ExecerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ExecerContext{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1947,16 +4259,11 @@ func get_conn_ExecerContext_ConnPrepareContext(connDetails DbConnDetails, conn d
 			ExecerContext: ExecerContext,
 			sqlSpan:       getSQLSpanData(connDetails),
 			sensor:        sensor,
-		},
-		ConnPrepareContext: &wConnPrepareContext{
-			ConnPrepareContext: ConnPrepareContext,
-			connDetails:        connDetails,
-			sensor:             sensor,
 		}}
 }
 
-func get_conn_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Queryer_QueryerContext{
+func get_conn_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_Queryer{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
@@ -1966,15 +4273,10 @@ func get_conn_Queryer_QueryerContext(connDetails DbConnDetails, conn driver.Conn
 			Queryer: Queryer,
 			sqlSpan: getSQLSpanData(connDetails),
 			sensor:  sensor,
-		},
-		QueryerContext: &wQueryerContext{
-			QueryerContext: QueryerContext,
-			sqlSpan:        getSQLSpanData(connDetails),
-			sensor:         sensor,
 		}}
 }
 
-func get_conn_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
+func get_conn_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
 	return &w_conn_QueryerContext{
 		Conn: &wConn{
 			Conn:        conn,
@@ -1988,21 +4290,7 @@ func get_conn_QueryerContext(connDetails DbConnDetails, conn driver.Conn, sensor
 		}}
 }
 
-func get_conn_Execer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Execer{
-		Conn: &wConn{
-			Conn:        conn,
-			connDetails: connDetails,
-			sensor:      sensor,
-		},
-		Execer: &wExecer{
-			Execer:  Execer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		}}
-}
-
-func get_conn_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
+func get_conn_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
 	return &w_conn_ConnPrepareContext{
 		Conn: &wConn{
 			Conn:        conn,
@@ -2016,21 +4304,7 @@ func get_conn_ConnPrepareContext(connDetails DbConnDetails, conn driver.Conn, se
 		}}
 }
 
-func get_conn_Queryer(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_Queryer{
-		Conn: &wConn{
-			Conn:        conn,
-			connDetails: connDetails,
-			sensor:      sensor,
-		},
-		Queryer: &wQueryer{
-			Queryer: Queryer,
-			sqlSpan: getSQLSpanData(connDetails),
-			sensor:  sensor,
-		}}
-}
-
-func get_conn_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
+func get_conn_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
 	return &w_conn_NamedValueChecker{
 		Conn: &wConn{
 			Conn:        conn,
@@ -2039,17 +4313,17 @@ func get_conn_NamedValueChecker(connDetails DbConnDetails, conn driver.Conn, sen
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_conn_ExecerContext(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker) driver.Conn {
-	return &w_conn_ExecerContext{
+func get_conn_ConnBeginTx(connDetails DbConnDetails, conn driver.Conn, sensor TracerLogger, Execer driver.Execer, ExecerContext driver.ExecerContext, Queryer driver.Queryer, QueryerContext driver.QueryerContext, ConnPrepareContext driver.ConnPrepareContext, NamedValueChecker driver.NamedValueChecker, ConnBeginTx driver.ConnBeginTx) driver.Conn {
+	return &w_conn_ConnBeginTx{
 		Conn: &wConn{
 			Conn:        conn,
 			connDetails: connDetails,
 			sensor:      sensor,
 		},
-		ExecerContext: &wExecerContext{
-			ExecerContext: ExecerContext,
-			sqlSpan:       getSQLSpanData(connDetails),
-			sensor:        sensor,
+		ConnBeginTx: &wConnBeginTx{
+			ConnBeginTx: ConnBeginTx,
+			connDetails: connDetails,
+			sensor:      sensor,
 		}}
 }
 
@@ -2090,17 +4364,21 @@ func get_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker(stmt driver.Stm
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter{
+func get_stmt_StmtExecContext_StmtQueryContext_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter{
 		Stmt: &wStmt{
 			Stmt:    stmt,
 			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
 			sensor:  sensor,
+		}, StmtExecContext: &wStmtExecContext{
+			StmtExecContext: StmtExecContext,
+			sensor:          sensor,
+			sqlSpan:         getSQLSpanData(connDetails, withQuery(query)),
 		}, StmtQueryContext: &wStmtQueryContext{
 			StmtQueryContext: StmtQueryContext,
 			sensor:           sensor,
 			sqlSpan:          getSQLSpanData(connDetails, withQuery(query)),
-		}, NamedValueChecker: NamedValueChecker,
+		},
 		cc: ColumnConverter}
 }
 
@@ -2118,36 +4396,48 @@ func get_stmt_StmtExecContext_NamedValueChecker_ColumnConverter(stmt driver.Stmt
 		cc: ColumnConverter}
 }
 
-func get_stmt_StmtExecContext_StmtQueryContext_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter{
+func get_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter{
 		Stmt: &wStmt{
 			Stmt:    stmt,
 			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
 			sensor:  sensor,
-		}, StmtExecContext: &wStmtExecContext{
-			StmtExecContext: StmtExecContext,
-			sensor:          sensor,
-			sqlSpan:         getSQLSpanData(connDetails, withQuery(query)),
 		}, StmtQueryContext: &wStmtQueryContext{
 			StmtQueryContext: StmtQueryContext,
 			sensor:           sensor,
 			sqlSpan:          getSQLSpanData(connDetails, withQuery(query)),
-		},
+		}, NamedValueChecker: NamedValueChecker,
 		cc: ColumnConverter}
 }
 
-func get_stmt_StmtQueryContext_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_StmtQueryContext_ColumnConverter{
+func get_stmt_StmtExecContext_StmtQueryContext(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_StmtExecContext_StmtQueryContext{
 		Stmt: &wStmt{
 			Stmt:    stmt,
 			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
 			sensor:  sensor,
+		}, StmtExecContext: &wStmtExecContext{
+			StmtExecContext: StmtExecContext,
+			sensor:          sensor,
+			sqlSpan:         getSQLSpanData(connDetails, withQuery(query)),
 		}, StmtQueryContext: &wStmtQueryContext{
 			StmtQueryContext: StmtQueryContext,
 			sensor:           sensor,
 			sqlSpan:          getSQLSpanData(connDetails, withQuery(query)),
-		},
-		cc: ColumnConverter}
+		}}
+}
+
+func get_stmt_StmtExecContext_NamedValueChecker(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_StmtExecContext_NamedValueChecker{
+		Stmt: &wStmt{
+			Stmt:    stmt,
+			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
+			sensor:  sensor,
+		}, StmtExecContext: &wStmtExecContext{
+			StmtExecContext: StmtExecContext,
+			sensor:          sensor,
+			sqlSpan:         getSQLSpanData(connDetails, withQuery(query)),
+		}, NamedValueChecker: NamedValueChecker}
 }
 
 func get_stmt_StmtQueryContext_NamedValueChecker(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
@@ -2177,17 +4467,18 @@ func get_stmt_StmtExecContext_ColumnConverter(stmt driver.Stmt, query string, co
 		cc: ColumnConverter}
 }
 
-func get_stmt_StmtExecContext_NamedValueChecker(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_StmtExecContext_NamedValueChecker{
+func get_stmt_StmtQueryContext_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_StmtQueryContext_ColumnConverter{
 		Stmt: &wStmt{
 			Stmt:    stmt,
 			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
 			sensor:  sensor,
-		}, StmtExecContext: &wStmtExecContext{
-			StmtExecContext: StmtExecContext,
-			sensor:          sensor,
-			sqlSpan:         getSQLSpanData(connDetails, withQuery(query)),
-		}, NamedValueChecker: NamedValueChecker}
+		}, StmtQueryContext: &wStmtQueryContext{
+			StmtQueryContext: StmtQueryContext,
+			sensor:           sensor,
+			sqlSpan:          getSQLSpanData(connDetails, withQuery(query)),
+		},
+		cc: ColumnConverter}
 }
 
 func get_stmt_NamedValueChecker_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
@@ -2200,8 +4491,8 @@ func get_stmt_NamedValueChecker_ColumnConverter(stmt driver.Stmt, query string,
 		cc: ColumnConverter}
 }
 
-func get_stmt_StmtExecContext_StmtQueryContext(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_StmtExecContext_StmtQueryContext{
+func get_stmt_StmtExecContext(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_StmtExecContext{
 		Stmt: &wStmt{
 			Stmt:    stmt,
 			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
@@ -2210,33 +4501,19 @@ func get_stmt_StmtExecContext_StmtQueryContext(stmt driver.Stmt, query string, c
 			StmtExecContext: StmtExecContext,
 			sensor:          sensor,
 			sqlSpan:         getSQLSpanData(connDetails, withQuery(query)),
-		}, StmtQueryContext: &wStmtQueryContext{
-			StmtQueryContext: StmtQueryContext,
-			sensor:           sensor,
-			sqlSpan:          getSQLSpanData(connDetails, withQuery(query)),
 		}}
 }
 
-func get_stmt_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_ColumnConverter{
-		Stmt: &wStmt{
-			Stmt:    stmt,
-			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
-			sensor:  sensor,
-		},
-		cc: ColumnConverter}
-}
-
-func get_stmt_StmtExecContext(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_StmtExecContext{
+func get_stmt_StmtQueryContext(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_StmtQueryContext{
 		Stmt: &wStmt{
 			Stmt:    stmt,
 			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
 			sensor:  sensor,
-		}, StmtExecContext: &wStmtExecContext{
-			StmtExecContext: StmtExecContext,
-			sensor:          sensor,
-			sqlSpan:         getSQLSpanData(connDetails, withQuery(query)),
+		}, StmtQueryContext: &wStmtQueryContext{
+			StmtQueryContext: StmtQueryContext,
+			sensor:           sensor,
+			sqlSpan:          getSQLSpanData(connDetails, withQuery(query)),
 		}}
 }
 
@@ -2249,25 +4526,22 @@ func get_stmt_NamedValueChecker(stmt driver.Stmt, query string, connDetails DbCo
 		}, NamedValueChecker: NamedValueChecker}
 }
 
-func get_stmt_StmtQueryContext(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
-	return &w_stmt_StmtQueryContext{
+func get_stmt_ColumnConverter(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor TracerLogger, StmtExecContext driver.StmtExecContext, StmtQueryContext driver.StmtQueryContext, NamedValueChecker driver.NamedValueChecker, ColumnConverter driver.ColumnConverter) driver.Stmt {
+	return &w_stmt_ColumnConverter{
 		Stmt: &wStmt{
 			Stmt:    stmt,
 			sqlSpan: getSQLSpanData(connDetails, withQuery(query)),
 			sensor:  sensor,
-		}, StmtQueryContext: &wStmtQueryContext{
-			StmtQueryContext: StmtQueryContext,
-			sensor:           sensor,
-			sqlSpan:          getSQLSpanData(connDetails, withQuery(query)),
-		}}
+		},
+		cc: ColumnConverter}
 }
 
 // stmtAlreadyWrapped returns true if stmt is already instrumented
 func stmtAlreadyWrapped(stmt driver.Stmt) bool {
 	switch stmt.(type) {
-	case *wStmt, *w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter, *w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker, *w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter, *w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter, *w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter, *w_stmt_StmtQueryContext_ColumnConverter, *w_stmt_StmtQueryContext_NamedValueChecker, *w_stmt_StmtExecContext_ColumnConverter, *w_stmt_StmtExecContext_NamedValueChecker, *w_stmt_NamedValueChecker_ColumnConverter, *w_stmt_StmtExecContext_StmtQueryContext, *w_stmt_ColumnConverter, *w_stmt_StmtExecContext, *w_stmt_NamedValueChecker, *w_stmt_StmtQueryContext:
+	case *wStmt, *w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter, *w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker, *w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter, *w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter, *w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter, *w_stmt_StmtExecContext_StmtQueryContext, *w_stmt_StmtExecContext_NamedValueChecker, *w_stmt_StmtQueryContext_NamedValueChecker, *w_stmt_StmtExecContext_ColumnConverter, *w_stmt_StmtQueryContext_ColumnConverter, *w_stmt_NamedValueChecker_ColumnConverter, *w_stmt_StmtExecContext, *w_stmt_StmtQueryContext, *w_stmt_NamedValueChecker, *w_stmt_ColumnConverter:
 		return true
-	case w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter, w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker, w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter, w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter, w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter, w_stmt_StmtQueryContext_ColumnConverter, w_stmt_StmtQueryContext_NamedValueChecker, w_stmt_StmtExecContext_ColumnConverter, w_stmt_StmtExecContext_NamedValueChecker, w_stmt_NamedValueChecker_ColumnConverter, w_stmt_StmtExecContext_StmtQueryContext, w_stmt_ColumnConverter, w_stmt_StmtExecContext, w_stmt_NamedValueChecker, w_stmt_StmtQueryContext:
+	case w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker_ColumnConverter, w_stmt_StmtExecContext_StmtQueryContext_NamedValueChecker, w_stmt_StmtExecContext_StmtQueryContext_ColumnConverter, w_stmt_StmtExecContext_NamedValueChecker_ColumnConverter, w_stmt_StmtQueryContext_NamedValueChecker_ColumnConverter, w_stmt_StmtExecContext_StmtQueryContext, w_stmt_StmtExecContext_NamedValueChecker, w_stmt_StmtQueryContext_NamedValueChecker, w_stmt_StmtExecContext_ColumnConverter, w_stmt_StmtQueryContext_ColumnConverter, w_stmt_NamedValueChecker_ColumnConverter, w_stmt_StmtExecContext, w_stmt_StmtQueryContext, w_stmt_NamedValueChecker, w_stmt_ColumnConverter:
 		return true
 	}
 	return false
@@ -2293,73 +4567,137 @@ func wrapStmt(stmt driver.Stmt, query string, connDetails DbConnDetails, sensor
 
 // A map of all possible driver.Conn types. The key represents which interfaces are "turned on". eg: 0b1001.
 //
-// In the example above, the following constructor is returned: get_conn_Queryer_NamedValueChecker
+// In the example above, the following constructor is returned: get_conn_QueryerContext_ConnBeginTx
 //
-// Each bit sequentially represents the interfaces: Execer, ExecerContext, Queryer, QueryerContext, ConnPrepareContext, NamedValueChecker
-var _conn_n = map[int]func(DbConnDetails, driver.Conn, TracerLogger, driver.Execer, driver.ExecerContext, driver.Queryer, driver.QueryerContext, driver.ConnPrepareContext, driver.NamedValueChecker) driver.Conn{
-	0b1:      get_conn_NamedValueChecker,
-	0b10:     get_conn_ConnPrepareContext,
-	0b100:    get_conn_QueryerContext,
-	0b1000:   get_conn_Queryer,
-	0b10000:  get_conn_ExecerContext,
-	0b100000: get_conn_Execer,
-	0b100001: get_conn_Execer_NamedValueChecker,
-	0b10001:  get_conn_ExecerContext_NamedValueChecker,
-	0b100010: get_conn_Execer_ConnPrepareContext,
-	0b100011: get_conn_Execer_ConnPrepareContext_NamedValueChecker,
-	0b1001:   get_conn_Queryer_NamedValueChecker,
-	0b10010:  get_conn_ExecerContext_ConnPrepareContext,
-	0b100100: get_conn_Execer_QueryerContext,
-	0b100101: get_conn_Execer_QueryerContext_NamedValueChecker,
-	0b10011:  get_conn_ExecerContext_ConnPrepareContext_NamedValueChecker,
-	0b100110: get_conn_Execer_QueryerContext_ConnPrepareContext,
-	0b100111: get_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker,
-	0b101:    get_conn_QueryerContext_NamedValueChecker,
-	0b1010:   get_conn_Queryer_ConnPrepareContext,
-	0b10100:  get_conn_ExecerContext_QueryerContext,
-	0b101000: get_conn_Execer_Queryer,
-	0b101001: get_conn_Execer_Queryer_NamedValueChecker,
-	0b10101:  get_conn_ExecerContext_QueryerContext_NamedValueChecker,
-	0b101010: get_conn_Execer_Queryer_ConnPrepareContext,
-	0b101011: get_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker,
-	0b1011:   get_conn_Queryer_ConnPrepareContext_NamedValueChecker,
-	0b10110:  get_conn_ExecerContext_QueryerContext_ConnPrepareContext,
-	0b101100: get_conn_Execer_Queryer_QueryerContext,
-	0b101101: get_conn_Execer_Queryer_QueryerContext_NamedValueChecker,
-	0b10111:  get_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker,
-	0b101110: get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext,
-	0b101111: get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
-	0b11:     get_conn_ConnPrepareContext_NamedValueChecker,
-	0b110:    get_conn_QueryerContext_ConnPrepareContext,
-	0b1100:   get_conn_Queryer_QueryerContext,
-	0b11000:  get_conn_ExecerContext_Queryer,
-	0b110000: get_conn_Execer_ExecerContext,
-	0b110001: get_conn_Execer_ExecerContext_NamedValueChecker,
-	0b11001:  get_conn_ExecerContext_Queryer_NamedValueChecker,
-	0b110010: get_conn_Execer_ExecerContext_ConnPrepareContext,
-	0b110011: get_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker,
-	0b1101:   get_conn_Queryer_QueryerContext_NamedValueChecker,
-	0b11010:  get_conn_ExecerContext_Queryer_ConnPrepareContext,
-	0b110100: get_conn_Execer_ExecerContext_QueryerContext,
-	0b110101: get_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker,
-	0b11011:  get_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker,
-	0b110110: get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext,
-	0b110111: get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker,
-	0b111:    get_conn_QueryerContext_ConnPrepareContext_NamedValueChecker,
-	0b1110:   get_conn_Queryer_QueryerContext_ConnPrepareContext,
-	0b11100:  get_conn_ExecerContext_Queryer_QueryerContext,
-	0b111000: get_conn_Execer_ExecerContext_Queryer,
-	0b111001: get_conn_Execer_ExecerContext_Queryer_NamedValueChecker,
-	0b11101:  get_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker,
-	0b111010: get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext,
-	0b111011: get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker,
-	0b1111:   get_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
-	0b11110:  get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext,
-	0b111100: get_conn_Execer_ExecerContext_Queryer_QueryerContext,
-	0b111101: get_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker,
-	0b11111:  get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
-	0b111110: get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext,
-	0b111111: get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
+// Each bit sequentially represents the interfaces: Execer, ExecerContext, Queryer, QueryerContext, ConnPrepareContext, NamedValueChecker, ConnBeginTx
+var _conn_n = map[int]func(DbConnDetails, driver.Conn, TracerLogger, driver.Execer, driver.ExecerContext, driver.Queryer, driver.QueryerContext, driver.ConnPrepareContext, driver.NamedValueChecker, driver.ConnBeginTx) driver.Conn{
+	0b1:       get_conn_ConnBeginTx,
+	0b10:      get_conn_NamedValueChecker,
+	0b100:     get_conn_ConnPrepareContext,
+	0b1000:    get_conn_QueryerContext,
+	0b10000:   get_conn_Queryer,
+	0b100000:  get_conn_ExecerContext,
+	0b1000000: get_conn_Execer,
+	0b1000001: get_conn_Execer_ConnBeginTx,
+	0b100001:  get_conn_ExecerContext_ConnBeginTx,
+	0b1000010: get_conn_Execer_NamedValueChecker,
+	0b1000011: get_conn_Execer_NamedValueChecker_ConnBeginTx,
+	0b10001:   get_conn_Queryer_ConnBeginTx,
+	0b100010:  get_conn_ExecerContext_NamedValueChecker,
+	0b1000100: get_conn_Execer_ConnPrepareContext,
+	0b1000101: get_conn_Execer_ConnPrepareContext_ConnBeginTx,
+	0b100011:  get_conn_ExecerContext_NamedValueChecker_ConnBeginTx,
+	0b1000110: get_conn_Execer_ConnPrepareContext_NamedValueChecker,
+	0b1000111: get_conn_Execer_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1001:    get_conn_QueryerContext_ConnBeginTx,
+	0b10010:   get_conn_Queryer_NamedValueChecker,
+	0b100100:  get_conn_ExecerContext_ConnPrepareContext,
+	0b1001000: get_conn_Execer_QueryerContext,
+	0b1001001: get_conn_Execer_QueryerContext_ConnBeginTx,
+	0b100101:  get_conn_ExecerContext_ConnPrepareContext_ConnBeginTx,
+	0b1001010: get_conn_Execer_QueryerContext_NamedValueChecker,
+	0b1001011: get_conn_Execer_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b10011:   get_conn_Queryer_NamedValueChecker_ConnBeginTx,
+	0b100110:  get_conn_ExecerContext_ConnPrepareContext_NamedValueChecker,
+	0b1001100: get_conn_Execer_QueryerContext_ConnPrepareContext,
+	0b1001101: get_conn_Execer_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b100111:  get_conn_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1001110: get_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b1001111: get_conn_Execer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b101:     get_conn_ConnPrepareContext_ConnBeginTx,
+	0b1010:    get_conn_QueryerContext_NamedValueChecker,
+	0b10100:   get_conn_Queryer_ConnPrepareContext,
+	0b101000:  get_conn_ExecerContext_QueryerContext,
+	0b1010000: get_conn_Execer_Queryer,
+	0b1010001: get_conn_Execer_Queryer_ConnBeginTx,
+	0b101001:  get_conn_ExecerContext_QueryerContext_ConnBeginTx,
+	0b1010010: get_conn_Execer_Queryer_NamedValueChecker,
+	0b1010011: get_conn_Execer_Queryer_NamedValueChecker_ConnBeginTx,
+	0b10101:   get_conn_Queryer_ConnPrepareContext_ConnBeginTx,
+	0b101010:  get_conn_ExecerContext_QueryerContext_NamedValueChecker,
+	0b1010100: get_conn_Execer_Queryer_ConnPrepareContext,
+	0b1010101: get_conn_Execer_Queryer_ConnPrepareContext_ConnBeginTx,
+	0b101011:  get_conn_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b1010110: get_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker,
+	0b1010111: get_conn_Execer_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1011:    get_conn_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b10110:   get_conn_Queryer_ConnPrepareContext_NamedValueChecker,
+	0b101100:  get_conn_ExecerContext_QueryerContext_ConnPrepareContext,
+	0b1011000: get_conn_Execer_Queryer_QueryerContext,
+	0b1011001: get_conn_Execer_Queryer_QueryerContext_ConnBeginTx,
+	0b101101:  get_conn_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b1011010: get_conn_Execer_Queryer_QueryerContext_NamedValueChecker,
+	0b1011011: get_conn_Execer_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b10111:   get_conn_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b101110:  get_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b1011100: get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext,
+	0b1011101: get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b101111:  get_conn_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1011110: get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b1011111: get_conn_Execer_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b11:      get_conn_NamedValueChecker_ConnBeginTx,
+	0b110:     get_conn_ConnPrepareContext_NamedValueChecker,
+	0b1100:    get_conn_QueryerContext_ConnPrepareContext,
+	0b11000:   get_conn_Queryer_QueryerContext,
+	0b110000:  get_conn_ExecerContext_Queryer,
+	0b1100000: get_conn_Execer_ExecerContext,
+	0b1100001: get_conn_Execer_ExecerContext_ConnBeginTx,
+	0b110001:  get_conn_ExecerContext_Queryer_ConnBeginTx,
+	0b1100010: get_conn_Execer_ExecerContext_NamedValueChecker,
+	0b1100011: get_conn_Execer_ExecerContext_NamedValueChecker_ConnBeginTx,
+	0b11001:   get_conn_Queryer_QueryerContext_ConnBeginTx,
+	0b110010:  get_conn_ExecerContext_Queryer_NamedValueChecker,
+	0b1100100: get_conn_Execer_ExecerContext_ConnPrepareContext,
+	0b1100101: get_conn_Execer_ExecerContext_ConnPrepareContext_ConnBeginTx,
+	0b110011:  get_conn_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx,
+	0b1100110: get_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker,
+	0b1100111: get_conn_Execer_ExecerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1101:    get_conn_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b11010:   get_conn_Queryer_QueryerContext_NamedValueChecker,
+	0b110100:  get_conn_ExecerContext_Queryer_ConnPrepareContext,
+	0b1101000: get_conn_Execer_ExecerContext_QueryerContext,
+	0b1101001: get_conn_Execer_ExecerContext_QueryerContext_ConnBeginTx,
+	0b110101:  get_conn_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx,
+	0b1101010: get_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker,
+	0b1101011: get_conn_Execer_ExecerContext_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b11011:   get_conn_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b110110:  get_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker,
+	0b1101100: get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext,
+	0b1101101: get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b110111:  get_conn_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1101110: get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b1101111: get_conn_Execer_ExecerContext_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b111:     get_conn_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1110:    get_conn_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b11100:   get_conn_Queryer_QueryerContext_ConnPrepareContext,
+	0b111000:  get_conn_ExecerContext_Queryer_QueryerContext,
+	0b1110000: get_conn_Execer_ExecerContext_Queryer,
+	0b1110001: get_conn_Execer_ExecerContext_Queryer_ConnBeginTx,
+	0b111001:  get_conn_ExecerContext_Queryer_QueryerContext_ConnBeginTx,
+	0b1110010: get_conn_Execer_ExecerContext_Queryer_NamedValueChecker,
+	0b1110011: get_conn_Execer_ExecerContext_Queryer_NamedValueChecker_ConnBeginTx,
+	0b11101:   get_conn_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b111010:  get_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker,
+	0b1110100: get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext,
+	0b1110101: get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_ConnBeginTx,
+	0b111011:  get_conn_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b1110110: get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker,
+	0b1110111: get_conn_Execer_ExecerContext_Queryer_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1111:    get_conn_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b11110:   get_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b111100:  get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext,
+	0b1111000: get_conn_Execer_ExecerContext_Queryer_QueryerContext,
+	0b1111001: get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnBeginTx,
+	0b111101:  get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b1111010: get_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker,
+	0b1111011: get_conn_Execer_ExecerContext_Queryer_QueryerContext_NamedValueChecker_ConnBeginTx,
+	0b11111:   get_conn_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b111110:  get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b1111100: get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext,
+	0b1111101: get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_ConnBeginTx,
+	0b111111:  get_conn_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
+	0b1111110: get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker,
+	0b1111111: get_conn_Execer_ExecerContext_Queryer_QueryerContext_ConnPrepareContext_NamedValueChecker_ConnBeginTx,
 }
 
 // A map of all possible driver.Stmt types. The key represents which interfaces are "turned on". eg: 0b1001.
@@ -171,7 +171,7 @@ func Test_connWrap(t *testing.T) {
 
 	var conns []driver.Conn
 	for _, f := range _conn_n {
-		conns = append(conns, f(DbConnDetails{}, nil, nil, nil, nil, nil, nil, nil, nil))
+		conns = append(conns, f(DbConnDetails{}, nil, nil, nil, nil, nil, nil, nil, nil, nil))
 	}
 
 	for _, v := range conns {
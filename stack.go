@@ -0,0 +1,72 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"runtime"
+	"strings"
+)
+
+// maxStackFrames is the maximum number of call stack frames attached to a span
+const maxStackFrames = 32
+
+// maxStackDepth is the maximum number of call stack frames inspected to find the location of a panic
+const maxStackDepth = 128
+
+// StackFrame is a single frame of the call stack attached to a span
+type StackFrame struct {
+	File   string `json:"c"`
+	Line   int    `json:"n"`
+	Method string `json:"m"`
+}
+
+// captureStack returns the call stack of the caller, skipping the provided number of frames. The skip
+// value of 0 identifies the frame of the function calling captureStack().
+func captureStack(skip int) []StackFrame {
+	return collectStackFrames(skip+1, false)
+}
+
+// capturePanicStack returns the call stack of a panicking goroutine starting at the location of the panic.
+// It is intended to be called by the functions deferred while panicking.
+func capturePanicStack(skip int) []StackFrame {
+	return collectStackFrames(skip+1, true)
+}
+
+func collectStackFrames(skip int, fromPanic bool) []StackFrame {
+	pcs := make([]uintptr, maxStackDepth)
+
+	n := runtime.Callers(skip+2, pcs)
+	if n == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(pcs[:n])
+
+	stack := make([]StackFrame, 0, n)
+	for {
+		fr, more := frames.Next()
+
+		switch {
+		case fromPanic && fr.Function == "runtime.gopanic":
+			// the frames above runtime.gopanic() belong to the deferred functions handling the panic
+			stack = stack[:0]
+		case !strings.HasPrefix(fr.Function, "runtime."):
+			// the frames of the runtime are not relevant to the user code
+			stack = append(stack, StackFrame{
+				File:   fr.File,
+				Line:   fr.Line,
+				Method: fr.Function,
+			})
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	if len(stack) > maxStackFrames {
+		stack = stack[:maxStackFrames]
+	}
+
+	return stack
+}
@@ -0,0 +1,79 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+// AllDatabaseSpans is the TracerOptions.NormalizeStatements value that enables the normalization
+// of database statements for all span types
+const AllDatabaseSpans = "*"
+
+// sqlDialectTag is an internal tag used by the database/sql instrumentation to pass the SQL dialect
+// of a generic SQL span to the statement normalizer. It is never sent to the agent.
+const sqlDialectTag = "sql_dialect"
+
+// statementNormalizers maps the span tags containing database statements to the functions used to normalize them
+var statementNormalizers = map[string]func(r *spanS, stmt string) string{
+	"pg.stmt":               sqlStatementNormalizer(PostgreSQLDialect),
+	"mysql.stmt":            sqlStatementNormalizer(MySQLDialect),
+	"couchbase.sql":         sqlStatementNormalizer(GenericSQLDialect),
+	"cosmos.cmd":            sqlStatementNormalizer(GenericSQLDialect),
+	string(ext.DBStatement): normalizeGenericSQLStatement,
+	"mongo.query":           mongoStatementNormalizer,
+	"mongo.filter":          mongoStatementNormalizer,
+	"mongo.json":            mongoStatementNormalizer,
+}
+
+func sqlStatementNormalizer(dialect SQLDialect) func(*spanS, string) string {
+	return func(_ *spanS, stmt string) string {
+		return NormalizeSQL(stmt, dialect)
+	}
+}
+
+// normalizeGenericSQLStatement normalizes the db.statement tag value using the dialect provided by the
+// instrumentation or the db.type tag
+func normalizeGenericSQLStatement(r *spanS, stmt string) string {
+	name, ok := r.Tags[sqlDialectTag].(string)
+	if !ok {
+		name, _ = r.Tags[string(ext.DBType)].(string)
+	}
+
+	return NormalizeSQL(stmt, sqlDialectByName(name))
+}
+
+// mongoStatementNormalizer normalizes the query, filter and command documents of a Mongo span. The collection
+// and database names are reported separately in the mongo.namespace tag.
+func mongoStatementNormalizer(_ *spanS, stmt string) string {
+	return normalizeMongoDocument(stmt, false)
+}
+
+// normalizeStatements replaces literals in database statements with placeholders if the normalization
+// is enabled for the span type.
+//
+//	This method doesn't take the lock, so make sure to have it
+//	locked before calling.
+func (r *spanS) normalizeStatements(spanTypes []string) {
+	defer delete(r.Tags, sqlDialectTag)
+
+	if !statementNormalizationEnabled(spanTypes, r.Operation) {
+		return
+	}
+
+	for k, normalize := range statementNormalizers {
+		if stmt, ok := r.Tags[k].(string); ok && stmt != "" {
+			r.Tags[k] = normalize(r, stmt)
+		}
+	}
+}
+
+func statementNormalizationEnabled(spanTypes []string, operation string) bool {
+	for _, st := range spanTypes {
+		if st == AllDatabaseSpans || st == operation {
+			return true
+		}
+	}
+
+	return false
+}
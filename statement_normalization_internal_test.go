@@ -0,0 +1,59 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"testing"
+
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpanS_normalizeStatements_SQLDialectTag(t *testing.T) {
+	examples := map[string]struct {
+		SpanTypes []string
+		Expected  string
+	}{
+		"enabled": {
+			SpanTypes: []string{AllDatabaseSpans},
+			Expected:  "SELECT * FROM t WHERE note = ? AND id = :id",
+		},
+		"disabled": {
+			Expected: "SELECT * FROM t WHERE note = q'[it's]' AND id = :id",
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			sp := &spanS{
+				Operation: "sdk.database",
+				Tags: ot.Tags{
+					sqlDialectTag:           OracleDialect.String(),
+					string(ext.DBStatement): "SELECT * FROM t WHERE note = q'[it's]' AND id = :id",
+				},
+			}
+
+			sp.normalizeStatements(example.SpanTypes)
+
+			assert.Equal(t, ot.Tags{string(ext.DBStatement): example.Expected}, sp.Tags, "the dialect tag should be removed")
+		})
+	}
+}
+
+func TestSpanS_normalizeStatements_MongoDocuments(t *testing.T) {
+	sp := &spanS{
+		Operation: string(MongoDBSpanType),
+		Tags: ot.Tags{
+			"mongo.namespace": "app.users",
+			"mongo.filter":    `{"count": {"$gt": 3}, "collection": "stamps"}`,
+		},
+	}
+
+	sp.normalizeStatements([]string{AllDatabaseSpans})
+
+	assert.Equal(t, ot.Tags{
+		"mongo.namespace": "app.users",
+		"mongo.filter":    `{"count":{"$gt":"?"},"collection":"?"}`,
+	}, sp.Tags)
+}
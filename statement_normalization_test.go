@@ -0,0 +1,57 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana_test
+
+import (
+	"testing"
+
+	instana "github.com/instana/go-sensor"
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeStatements(t *testing.T) {
+	recorder := instana.NewTestRecorder()
+	c := instana.InitCollector(&instana.Options{
+		AgentClient: alwaysReadyClient{},
+		Recorder:    recorder,
+		Tracer: instana.TracerOptions{
+			NormalizeStatements: []string{string(instana.PostgreSQLSpanType), "sdk.database"},
+		},
+	})
+	defer instana.ShutdownCollector()
+
+	entry := c.StartSpan("entry", ext.SpanKindRPCServer)
+
+	c.StartSpan(string(instana.PostgreSQLSpanType), ext.SpanKindRPCClient, ot.ChildOf(entry.Context()), ot.Tags{
+		"pg.stmt": "SELECT * FROM users WHERE id = 42",
+	}).Finish()
+
+	c.StartSpan(string(instana.MySQLSpanType), ext.SpanKindRPCClient, ot.ChildOf(entry.Context()), ot.Tags{
+		"mysql.stmt": "SELECT * FROM users WHERE id = 42",
+	}).Finish()
+
+	c.StartSpan("sdk.database", ext.SpanKindRPCClient, ot.ChildOf(entry.Context()), ot.Tags{
+		string(ext.DBType):      "mysql",
+		string(ext.DBStatement): `SELECT * FROM users WHERE email = "jdoe@example.com"`,
+	}).Finish()
+
+	entry.Finish()
+
+	spans := recorder.GetQueuedSpans()
+	require.Len(t, spans, 4)
+
+	pgData, ok := spans[0].Data.(instana.PostgreSQLSpanData)
+	require.True(t, ok)
+	assert.Equal(t, "SELECT * FROM users WHERE id = ?", pgData.Tags.Stmt)
+
+	mysqlData, ok := spans[1].Data.(instana.MySQLSpanData)
+	require.True(t, ok)
+	assert.Equal(t, "SELECT * FROM users WHERE id = 42", mysqlData.Tags.Stmt, "normalization is not enabled for mysql spans")
+
+	sdkData, ok := spans[2].Data.(instana.SDKSpanData)
+	require.True(t, ok)
+	assert.Equal(t, "SELECT * FROM users WHERE email = ?", sdkData.Tags.Custom["tags"].(ot.Tags)[string(ext.DBStatement)])
+}
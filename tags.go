@@ -12,6 +12,7 @@ import (
 
 const (
 	batchSizeTag       = "batch_size"
+	linksTag           = "span_links"
 	suppressTracingTag = "suppress_tracing"
 	syntheticCallTag   = "synthetic_call"
 )
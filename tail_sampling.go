@@ -0,0 +1,428 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+)
+
+const (
+	// DefaultTailSamplingDecisionWait is the default amount of time a trace is held before the sampling decision is made
+	DefaultTailSamplingDecisionWait = 5 * time.Second
+	// DefaultTailSamplingMaxTraces is the default maximum number of traces held by the tail-sampling recorder
+	DefaultTailSamplingMaxTraces = 1000
+	// DefaultTailSamplingMaxSpansPerTrace is the default maximum number of spans held for a single trace
+	DefaultTailSamplingMaxSpansPerTrace = 1000
+)
+
+// TailSamplingSpan is a finished span as seen by the tail-sampling rules
+type TailSamplingSpan struct {
+	TraceIDHi  int64
+	TraceID    int64
+	SpanID     int64
+	ParentID   int64
+	Operation  string
+	Type       RegisteredSpanType
+	Kind       SpanKind
+	ErrorCount int
+	Duration   time.Duration
+	Tags       ot.Tags
+}
+
+// TailSamplingRule decides whether a trace containing the span should be kept
+type TailSamplingRule interface {
+	Keep(span TailSamplingSpan) bool
+}
+
+// TailSamplingRuleFunc is an adapter to allow the use of ordinary functions as tail-sampling rules
+type TailSamplingRuleFunc func(span TailSamplingSpan) bool
+
+// Keep calls f(span)
+func (f TailSamplingRuleFunc) Keep(span TailSamplingSpan) bool {
+	return f(span)
+}
+
+// KeepErroneousTraces returns a rule that keeps traces containing at least one span with errors
+func KeepErroneousTraces() TailSamplingRule {
+	return TailSamplingRuleFunc(func(span TailSamplingSpan) bool {
+		return span.ErrorCount > 0
+	})
+}
+
+// KeepSlowTraces returns a rule that keeps traces containing an entry span that took longer than
+// or equal to the threshold
+func KeepSlowTraces(threshold time.Duration) TailSamplingRule {
+	return TailSamplingRuleFunc(func(span TailSamplingSpan) bool {
+		return span.Kind == EntrySpanKind && span.Duration >= threshold
+	})
+}
+
+// KeepSpanTypes returns a rule that keeps traces containing a span of any of provided types
+func KeepSpanTypes(types ...RegisteredSpanType) TailSamplingRule {
+	return TailSamplingRuleFunc(func(span TailSamplingSpan) bool {
+		for _, st := range types {
+			if span.Type == st {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// KeepTracesWithTag returns a rule that keeps traces containing a span with the tag set to any of provided
+// values. If no values are provided, the presence of the tag is enough to keep the trace.
+func KeepTracesWithTag(key string, values ...interface{}) TailSamplingRule {
+	return TailSamplingRuleFunc(func(span TailSamplingSpan) bool {
+		v, ok := span.Tags[key]
+		if !ok {
+			return false
+		}
+
+		if len(values) == 0 {
+			return true
+		}
+
+		for _, expected := range values {
+			if reflect.DeepEqual(v, expected) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// TailSamplingOptions contains the configuration of the tail-sampling recorder
+type TailSamplingOptions struct {
+	// DecisionWait is the amount of time the spans of a trace are held after the first one has been recorded.
+	// Once it passes, the trace is either forwarded or dropped as a whole. Defaults to DefaultTailSamplingDecisionWait.
+	DecisionWait time.Duration
+	// MaxTraces is the maximum number of traces awaiting the decision. Whenever this limit is reached, the decision
+	// for the oldest trace is made ahead of time. Defaults to DefaultTailSamplingMaxTraces.
+	MaxTraces int
+	// MaxSpansPerTrace is the maximum number of spans held for a single trace. Once reached, the decision for
+	// this trace is made ahead of time. Defaults to DefaultTailSamplingMaxSpansPerTrace.
+	MaxSpansPerTrace int
+	// Rules is the list of rules to decide whether a trace should be kept. A trace is kept if any of its spans
+	// matches any of the rules. If there are no rules provided, all traces are kept.
+	Rules []TailSamplingRule
+}
+
+// TailSamplingStats contains the statistics collected by the tail-sampling recorder
+type TailSamplingStats struct {
+	// KeptTraces is the number of traces forwarded to the underlying recorder
+	KeptTraces uint64
+	// DroppedTraces is the number of traces discarded by the recorder
+	DroppedTraces uint64
+	// KeptSpans is the number of spans forwarded to the underlying recorder
+	KeptSpans uint64
+	// DroppedSpans is the number of spans discarded by the recorder
+	DroppedSpans uint64
+	// EarlyDecisions is the number of traces decided before the end of the decision window due to memory limits
+	EarlyDecisions uint64
+	// PendingTraces is the number of traces currently awaiting the decision
+	PendingTraces int
+}
+
+type traceKey struct {
+	hi, lo int64
+}
+
+type pendingTrace struct {
+	key       traceKey
+	createdAt time.Time
+	spans     []*spanS
+	keep      bool
+}
+
+// TailSamplingRecorder is a SpanRecorder that groups finished spans by their trace ID and holds them for
+// a bounded decision window. After the window passes, the whole trace is either forwarded to the underlying
+// recorder or dropped depending on the configured rules.
+type TailSamplingRecorder struct {
+	next SpanRecorder
+	opts TailSamplingOptions
+
+	mu      sync.Mutex
+	pending map[traceKey]*pendingTrace
+	order   []traceKey
+
+	// decisions keeps recent sampling decisions to handle spans that finish after their trace has been decided
+	decisions     map[traceKey]bool
+	decisionOrder []traceKey
+
+	keptTraces, droppedTraces atomic.Uint64
+	keptSpans, droppedSpans   atomic.Uint64
+	earlyDecisions            atomic.Uint64
+	closeOnce                 sync.Once
+	done                      chan struct{}
+	now                       func() time.Time
+}
+
+// NewTailSamplingRecorder initializes a new tail-sampling recorder that forwards kept traces to the next recorder.
+// If next is nil, instana.NewRecorder() is used.
+func NewTailSamplingRecorder(next SpanRecorder, opts TailSamplingOptions) *TailSamplingRecorder {
+	rec := newTailSamplingRecorder(next, opts, time.Now)
+
+	go func(r *TailSamplingRecorder) {
+		ticker := time.NewTicker(tailSamplingCheckInterval(r.opts.DecisionWait))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.done:
+				return
+			case <-ticker.C:
+				r.decideExpired()
+			}
+		}
+	}(rec)
+
+	return rec
+}
+
+func newTailSamplingRecorder(next SpanRecorder, opts TailSamplingOptions, now func() time.Time) *TailSamplingRecorder {
+	if next == nil {
+		next = NewRecorder()
+	}
+
+	if opts.DecisionWait <= 0 {
+		opts.DecisionWait = DefaultTailSamplingDecisionWait
+	}
+
+	if opts.MaxTraces <= 0 {
+		opts.MaxTraces = DefaultTailSamplingMaxTraces
+	}
+
+	if opts.MaxSpansPerTrace <= 0 {
+		opts.MaxSpansPerTrace = DefaultTailSamplingMaxSpansPerTrace
+	}
+
+	return &TailSamplingRecorder{
+		next:      next,
+		opts:      opts,
+		pending:   make(map[traceKey]*pendingTrace),
+		decisions: make(map[traceKey]bool),
+		done:      make(chan struct{}),
+		now:       now,
+	}
+}
+
+// tailSamplingCheckInterval returns how often the pending traces are checked for expired decision windows
+func tailSamplingCheckInterval(wait time.Duration) time.Duration {
+	interval := wait / 4
+
+	switch {
+	case interval < 10*time.Millisecond:
+		return 10 * time.Millisecond
+	case interval > time.Second:
+		return time.Second
+	default:
+		return interval
+	}
+}
+
+// RecordSpan adds the span to the group of its trace. Spans that belong to an already decided trace
+// are forwarded or dropped right away.
+func (r *TailSamplingRecorder) RecordSpan(span *spanS) {
+	key := traceKey{span.context.TraceIDHi, span.context.TraceID}
+	keep := r.evaluate(span)
+
+	var decided []*pendingTrace
+
+	r.mu.Lock()
+	if kept, ok := r.decisions[key]; ok {
+		r.mu.Unlock()
+
+		if kept {
+			r.keptSpans.Add(1)
+			r.next.RecordSpan(span)
+		} else {
+			r.droppedSpans.Add(1)
+		}
+
+		return
+	}
+
+	tr, ok := r.pending[key]
+	if !ok {
+		if len(r.pending) >= r.opts.MaxTraces {
+			decided = append(decided, r.removeOldest())
+			r.earlyDecisions.Add(1)
+		}
+
+		tr = &pendingTrace{
+			key:       key,
+			createdAt: r.now(),
+		}
+		r.pending[key] = tr
+		r.order = append(r.order, key)
+	}
+
+	tr.spans = append(tr.spans, span)
+	tr.keep = tr.keep || keep
+
+	if len(tr.spans) >= r.opts.MaxSpansPerTrace {
+		r.remove(key)
+		decided = append(decided, tr)
+		r.earlyDecisions.Add(1)
+	}
+	r.mu.Unlock()
+
+	r.forward(decided)
+}
+
+// Flush makes the sampling decision for all pending traces and flushes the underlying recorder
+func (r *TailSamplingRecorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	decided := make([]*pendingTrace, 0, len(r.pending))
+	for len(r.order) > 0 {
+		decided = append(decided, r.removeOldest())
+	}
+	r.mu.Unlock()
+
+	r.forward(decided)
+
+	return r.next.Flush(ctx)
+}
+
+// Close stops the background decision loop. Pending traces are not flushed, use Flush() to do so
+// before closing the recorder.
+func (r *TailSamplingRecorder) Close() {
+	r.closeOnce.Do(func() { close(r.done) })
+}
+
+// Stats returns the tail-sampling statistics
+func (r *TailSamplingRecorder) Stats() TailSamplingStats {
+	r.mu.Lock()
+	pending := len(r.pending)
+	r.mu.Unlock()
+
+	return TailSamplingStats{
+		KeptTraces:     r.keptTraces.Load(),
+		DroppedTraces:  r.droppedTraces.Load(),
+		KeptSpans:      r.keptSpans.Load(),
+		DroppedSpans:   r.droppedSpans.Load(),
+		EarlyDecisions: r.earlyDecisions.Load(),
+		PendingTraces:  pending,
+	}
+}
+
+// decideExpired makes the sampling decision for traces whose decision window has passed
+func (r *TailSamplingRecorder) decideExpired() {
+	now := r.now()
+
+	var decided []*pendingTrace
+
+	r.mu.Lock()
+	for len(r.order) > 0 {
+		if now.Sub(r.pending[r.order[0]].createdAt) < r.opts.DecisionWait {
+			break
+		}
+
+		decided = append(decided, r.removeOldest())
+	}
+	r.mu.Unlock()
+
+	r.forward(decided)
+}
+
+// evaluate checks whether the span matches any of the configured rules
+func (r *TailSamplingRecorder) evaluate(span *spanS) bool {
+	if len(r.opts.Rules) == 0 {
+		return true
+	}
+
+	data := RegisteredSpanType(span.Operation).extractData(span)
+	ts := TailSamplingSpan{
+		TraceIDHi:  span.context.TraceIDHi,
+		TraceID:    span.context.TraceID,
+		SpanID:     span.context.SpanID,
+		ParentID:   span.context.ParentID,
+		Operation:  span.Operation,
+		Type:       data.Type(),
+		Kind:       data.Kind(),
+		ErrorCount: span.ErrorCount,
+		Duration:   span.Duration,
+		Tags:       cloneTags(span.Tags),
+	}
+
+	for _, rule := range r.opts.Rules {
+		if rule.Keep(ts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forward sends the spans of kept traces to the next recorder
+func (r *TailSamplingRecorder) forward(traces []*pendingTrace) {
+	if len(traces) == 0 {
+		return
+	}
+
+	for _, tr := range traces {
+		if !tr.keep {
+			r.droppedTraces.Add(1)
+			r.droppedSpans.Add(uint64(len(tr.spans)))
+
+			continue
+		}
+
+		r.keptTraces.Add(1)
+		r.keptSpans.Add(uint64(len(tr.spans)))
+
+		for _, sp := range tr.spans {
+			r.next.RecordSpan(sp)
+		}
+	}
+}
+
+// removeOldest removes the oldest pending trace, stores its sampling decision and returns it.
+// The caller must hold the lock.
+func (r *TailSamplingRecorder) removeOldest() *pendingTrace {
+	key := r.order[0]
+	r.order = r.order[1:]
+
+	tr := r.pending[key]
+	delete(r.pending, key)
+	r.rememberDecision(key, tr.keep)
+
+	return tr
+}
+
+// remove removes the pending trace with the given key and stores its sampling decision.
+// The caller must hold the lock.
+func (r *TailSamplingRecorder) remove(key traceKey) {
+	r.rememberDecision(key, r.pending[key].keep)
+	delete(r.pending, key)
+
+	for i, k := range r.order {
+		if k == key {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// rememberDecision stores the decision for a trace, evicting the oldest one once there are more than
+// MaxTraces decisions stored. The caller must hold the lock.
+func (r *TailSamplingRecorder) rememberDecision(key traceKey, keep bool) {
+	if _, ok := r.decisions[key]; !ok {
+		r.decisionOrder = append(r.decisionOrder, key)
+	}
+
+	r.decisions[key] = keep
+
+	if len(r.decisionOrder) > r.opts.MaxTraces {
+		delete(r.decisions, r.decisionOrder[0])
+		r.decisionOrder = r.decisionOrder[1:]
+	}
+}
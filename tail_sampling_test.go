@@ -0,0 +1,196 @@
+// (c) Copyright IBM Corp. 2026
+
+package instana
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ot "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTailSamplingTracer(t *testing.T, opts TailSamplingOptions) (*tracerS, *TailSamplingRecorder, *Recorder, *time.Time) {
+	t.Helper()
+
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	next := NewTestRecorder()
+	rec := newTailSamplingRecorder(next, opts, func() time.Time { return now })
+
+	tracer := NewTracerWithEverything(&Options{AgentClient: alwaysReadyClient{}}, rec)
+	t.Cleanup(ShutdownSensor)
+
+	return tracer, rec, next, &now
+}
+
+func TestTailSamplingRecorder_KeepErroneousTraces(t *testing.T) {
+	tracer, rec, next, now := newTestTailSamplingTracer(t, TailSamplingOptions{
+		DecisionWait: time.Second,
+		Rules:        []TailSamplingRule{KeepErroneousTraces()},
+	})
+
+	// trace with an error
+	sp := tracer.StartSpan("entry", ext.SpanKindRPCServer)
+	child := tracer.StartSpan("exit", ot.ChildOf(sp.Context()))
+	child.LogFields(otlog.Error(errors.New("something went wrong")))
+	child.Finish()
+	sp.Finish()
+
+	// trace without errors
+	tracer.StartSpan("entry", ext.SpanKindRPCServer).Finish()
+
+	rec.decideExpired()
+	assert.Equal(t, 0, next.QueuedSpansCount(), "spans are forwarded before the decision window passed")
+	assert.Equal(t, 2, rec.Stats().PendingTraces)
+
+	*now = now.Add(time.Second)
+	rec.decideExpired()
+
+	// the error log record is sent as a separate log.go span within the same trace
+	spans := next.GetQueuedSpans()
+	require.Len(t, spans, 3)
+	for _, sp := range spans {
+		assert.Equal(t, spans[0].TraceID, sp.TraceID)
+	}
+
+	assert.Equal(t, TailSamplingStats{
+		KeptTraces:    1,
+		DroppedTraces: 1,
+		KeptSpans:     3,
+		DroppedSpans:  1,
+	}, rec.Stats())
+}
+
+func TestTailSamplingRecorder_LateSpans(t *testing.T) {
+	tracer, rec, next, now := newTestTailSamplingTracer(t, TailSamplingOptions{
+		DecisionWait: time.Second,
+		Rules:        []TailSamplingRule{KeepSlowTraces(100 * time.Millisecond)},
+	})
+
+	start := time.Now()
+
+	kept := tracer.StartSpan("entry", ext.SpanKindRPCServer, ot.StartTime(start))
+	kept.FinishWithOptions(ot.FinishOptions{FinishTime: start.Add(time.Second)})
+
+	dropped := tracer.StartSpan("entry", ext.SpanKindRPCServer, ot.StartTime(start))
+	dropped.FinishWithOptions(ot.FinishOptions{FinishTime: start.Add(time.Millisecond)})
+
+	*now = now.Add(time.Second)
+	rec.decideExpired()
+	require.Len(t, next.GetQueuedSpans(), 1)
+
+	// spans finished after the decision follow the decision made for their trace
+	tracer.StartSpan("async", ot.ChildOf(kept.Context())).Finish()
+	tracer.StartSpan("async", ot.ChildOf(dropped.Context())).Finish()
+
+	assert.Len(t, next.GetQueuedSpans(), 1)
+	assert.Equal(t, 0, rec.Stats().PendingTraces)
+}
+
+func TestTailSamplingRecorder_MaxTraces(t *testing.T) {
+	tracer, rec, next, _ := newTestTailSamplingTracer(t, TailSamplingOptions{
+		MaxTraces: 2,
+	})
+
+	for i := 0; i < 3; i++ {
+		tracer.StartSpan("test").Finish()
+	}
+
+	assert.Len(t, next.GetQueuedSpans(), 1)
+	assert.Equal(t, TailSamplingStats{
+		KeptTraces:     1,
+		KeptSpans:      1,
+		EarlyDecisions: 1,
+		PendingTraces:  2,
+	}, rec.Stats())
+}
+
+func TestTailSamplingRecorder_MaxSpansPerTrace(t *testing.T) {
+	tracer, rec, next, _ := newTestTailSamplingTracer(t, TailSamplingOptions{
+		MaxSpansPerTrace: 2,
+		Rules:            []TailSamplingRule{KeepSpanTypes(HTTPClientSpanType)},
+	})
+
+	sp := tracer.StartSpan("entry")
+	tracer.StartSpan("http", ot.ChildOf(sp.Context())).Finish()
+	sp.Finish()
+
+	assert.Len(t, next.GetQueuedSpans(), 2)
+	assert.Equal(t, uint64(1), rec.Stats().EarlyDecisions)
+}
+
+func TestTailSamplingRecorder_Flush(t *testing.T) {
+	tracer, rec, _, _ := newTestTailSamplingTracer(t, TailSamplingOptions{
+		Rules: []TailSamplingRule{KeepTracesWithTag("keep")},
+	})
+
+	tracer.StartSpan("test", ot.Tag{Key: "keep", Value: true}).Finish()
+	tracer.StartSpan("test").Finish()
+
+	require.NoError(t, rec.Flush(context.Background()))
+
+	assert.Equal(t, TailSamplingStats{
+		KeptTraces:    1,
+		DroppedTraces: 1,
+		KeptSpans:     1,
+		DroppedSpans:  1,
+	}, rec.Stats())
+}
+
+func TestNewTailSamplingRecorder_DecisionLoop(t *testing.T) {
+	next := NewTestRecorder()
+	rec := NewTailSamplingRecorder(next, TailSamplingOptions{
+		DecisionWait: 20 * time.Millisecond,
+	})
+	defer rec.Close()
+
+	tracer := NewTracerWithEverything(&Options{AgentClient: alwaysReadyClient{}}, rec)
+	defer ShutdownSensor()
+
+	tracer.StartSpan("test").Finish()
+
+	assert.Eventually(t, func() bool {
+		return next.QueuedSpansCount() == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestKeepTracesWithTag(t *testing.T) {
+	examples := map[string]struct {
+		Rule     TailSamplingRule
+		Tags     ot.Tags
+		Expected bool
+	}{
+		"tag present, any value": {
+			Rule:     KeepTracesWithTag("key"),
+			Tags:     ot.Tags{"key": "value"},
+			Expected: true,
+		},
+		"tag absent": {
+			Rule:     KeepTracesWithTag("key"),
+			Tags:     ot.Tags{"other": "value"},
+			Expected: false,
+		},
+		"tag value matches": {
+			Rule:     KeepTracesWithTag("http.status", 500, 503),
+			Tags:     ot.Tags{"http.status": 503},
+			Expected: true,
+		},
+		"tag value does not match": {
+			Rule:     KeepTracesWithTag("http.status", 500, 503),
+			Tags:     ot.Tags{"http.status": 200},
+			Expected: false,
+		},
+	}
+
+	for name, example := range examples {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, example.Expected, example.Rule.Keep(TailSamplingSpan{Tags: example.Tags}))
+		})
+	}
+}
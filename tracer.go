@@ -55,7 +55,14 @@ func (r *tracerS) Inject(spanContext ot.SpanContext, format interface{}, carrier
 			return ot.ErrInvalidSpanContext
 		}
 
-		return injectTraceContext(sc, carrier)
+		return r.propagator().Inject(sc, carrier)
+	case ot.Binary:
+		sc, ok := spanContext.(SpanContext)
+		if !ok {
+			return ot.ErrInvalidSpanContext
+		}
+
+		return injectBinaryTraceContext(sc, carrier)
 	}
 
 	return ot.ErrUnsupportedFormat
@@ -64,7 +71,14 @@ func (r *tracerS) Inject(spanContext ot.SpanContext, format interface{}, carrier
 func (r *tracerS) Extract(format interface{}, carrier interface{}) (ot.SpanContext, error) {
 	switch format {
 	case ot.TextMap, ot.HTTPHeaders:
-		sc, err := extractTraceContext(carrier)
+		sc, err := r.propagator().Extract(carrier)
+		if err != nil {
+			return nil, err
+		}
+
+		return sc, nil
+	case ot.Binary:
+		sc, err := extractBinaryTraceContext(carrier)
 		if err != nil {
 			return nil, err
 		}
@@ -90,17 +104,35 @@ func (r *tracerS) StartSpanWithOptions(operationName string, opts ot.StartSpanOp
 		startTime = time.Now()
 	}
 
-	var corrData EUMCorrelationData
+	var (
+		corrData    EUMCorrelationData
+		parentSc    *SpanContext
+		followsFrom bool
+	)
+
+	var links []SpanReference
 
 	sc := NewRootSpanContext()
 	for _, ref := range opts.References {
-		if ref.Type == ot.ChildOfRef || ref.Type == ot.FollowsFromRef {
-			if parent, ok := ref.ReferencedContext.(SpanContext); ok {
-				corrData = parent.Correlation
-				sc = NewSpanContext(parent)
-				break
-			}
+		if ref.Type != ot.ChildOfRef && ref.Type != ot.FollowsFromRef {
+			continue
+		}
+
+		parent, ok := ref.ReferencedContext.(SpanContext)
+		if !ok {
+			continue
+		}
+
+		// the first reference becomes the parent, any other reference is added as a link
+		if parentSc != nil {
+			links = append(links, WithLinks(parent)...)
+			continue
 		}
+
+		corrData = parent.Correlation
+		sc = NewSpanContext(parent)
+		parentSc = &parent
+		followsFrom = ref.Type == ot.FollowsFromRef
 	}
 
 	if tag, ok := opts.Tags[suppressTracingTag]; ok {
@@ -108,7 +140,18 @@ func (r *tracerS) StartSpanWithOptions(operationName string, opts ot.StartSpanOp
 		delete(opts.Tags, suppressTracingTag)
 	}
 
-	return &spanS{
+	if tag, ok := opts.Tags[linksTag]; ok {
+		tagLinks, _ := tag.([]SpanReference)
+		links = append(links, tagLinks...)
+		delete(opts.Tags, linksTag)
+	}
+
+	sc.Sampled = r.sample(operationName, sc, parentSc, opts.Tags)
+	if !sc.Sampled {
+		sc.Suppressed = true
+	}
+
+	sp := &spanS{
 		context:     sc,
 		tracer:      r,
 		Service:     sensor.serviceName,
@@ -116,8 +159,42 @@ func (r *tracerS) StartSpanWithOptions(operationName string, opts ot.StartSpanOp
 		Start:       startTime,
 		Duration:    -1,
 		Correlation: corrData,
+		Links:       uniqueSpanReferences(links),
+		FollowsFrom: followsFrom,
 		Tags:        cloneTags(opts.Tags),
 	}
+
+	for _, p := range spanProcessors() {
+		p.OnStart(sp)
+	}
+
+	return sp
+}
+
+// sample makes the sampling decision for a new span context. Suppressed contexts are never sampled. If a sampler
+// is configured, child spans always follow the decision made for their parent, so that a trace is either sampled
+// or dropped as a whole, and the sampler itself is only consulted for root spans.
+func (r *tracerS) sample(operationName string, sc SpanContext, parent *SpanContext, tags ot.Tags) bool {
+	if sc.Suppressed {
+		return false
+	}
+
+	sampler := r.Options().Sampler
+	if sampler == nil {
+		return true
+	}
+
+	if parent != nil {
+		return parentSampled(*parent)
+	}
+
+	return sampler.ShouldSample(SamplingParameters{
+		Operation: operationName,
+		TraceIDHi: sc.TraceIDHi,
+		TraceID:   sc.TraceID,
+		Parent:    parent,
+		Tags:      tags,
+	})
 }
 
 // Options returns current tracer options
@@ -126,7 +203,13 @@ func (r *tracerS) Options() TracerOptions {
 		return DefaultTracerOptions()
 	}
 
-	return sensor.options.Tracer
+	return sensor.tracerOptions()
+}
+
+// propagator returns the composite of the default Instana propagator and the propagators
+// enabled in the tracer options
+func (r *tracerS) propagator() Propagator {
+	return append(compositePropagator{instanaPropagator{}}, r.Options().Propagators...)
 }
 
 // Flush forces sending any queued finished spans to the agent
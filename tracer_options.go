@@ -17,6 +17,32 @@ type TracerOptions struct {
 	//
 	// See https://www.instana.com/docs/setup_and_manage/host_agent/configuration/#secrets for details
 	Secrets Matcher
+	// ValueRedactor replaces sensitive data found in the values of URLs, query parameters, database statements,
+	// collected HTTP headers and log messages, such as payment card numbers, email addresses or tokens.
+	// By default, the values are not redacted. Use instana.NewValueRedactor() to create a redactor for a set
+	// of built-in patterns and custom regular expressions.
+	//
+	// The value redactor can also be configured via the INSTANA_SECRET_VALUES env var, which takes precedence
+	// over the in-code configuration, or via the host agent configuration.
+	ValueRedactor ValueRedactor
+	// NormalizeStatements is the list of span types, such as "postgres", "mysql", "mongo" or "sdk.database" for
+	// the generic database/sql spans, which database statements are normalized before being sent to the agent.
+	// The normalization replaces string and numeric literals with "?" and collapses the IN (...) value lists,
+	// so that the statements do not contain customer data and can be grouped together. Use instana.AllDatabaseSpans
+	// to enable the normalization for all database spans. By default, the statements are sent as is.
+	//
+	// The list can also be provided as a comma-separated value of the INSTANA_NORMALIZE_STATEMENTS env var,
+	// which takes precedence over the in-code configuration.
+	NormalizeStatements []string
+	// Propagators is the list of additional trace context propagation formats, such as Zipkin B3 or Jaeger,
+	// used alongside the X-INSTANA-* and W3C Trace Context headers. The context is injected using all
+	// propagators, while the first propagator that finds a trace context in the carrier is used for
+	// extraction, starting with the Instana one. Use instana.NamedPropagator() to get one of the built-in
+	// propagators.
+	//
+	// The propagators can also be enabled by name via the INSTANA_PROPAGATORS env var, which takes precedence
+	// over the in-code configuration.
+	Propagators []Propagator
 	// CollectableHTTPHeaders is a case-insensitive list of HTTP headers to be collected from HTTP requests and sent to the agent
 	//
 	// See https://www.instana.com/docs/setup_and_manage/host_agent/configuration/#capture-custom-http-headers for details
@@ -27,6 +53,16 @@ type TracerOptions struct {
 	// The main benefit of disabling is reducing the overall amount of data being collected and processed.
 	DisableSpans map[string]bool
 
+	// Sampler makes the head-based sampling decision for new traces. Spans that belong to a trace that
+	// has not been sampled are not sent to the agent, and the decision is propagated to downstream services.
+	// The sampler is only consulted for root spans, while child spans always follow the decision made for
+	// their parent. If nil, all traces are sampled. Package-level constructors, such as instana.NewProbabilisticSampler(),
+	// instana.NewRateLimitingSampler() and instana.NewParentBasedSampler() provide a set of built-in samplers.
+	//
+	// The sampler can also be configured via the INSTANA_SAMPLER env var or the configuration file
+	// provided via INSTANA_CONFIG_PATH, in which case it takes precedence over the in-code configuration.
+	Sampler Sampler
+
 	// tracerDefaultSecrets flag is used to identify whether tracerOptions.Secrets
 	// contains the default secret matcher configured by the Instana SDK.
 	//
@@ -39,6 +75,15 @@ type TracerOptions struct {
 	//
 	// Later, this flag will also be used to override the secret matcher configuration received from the agent during the handshake.
 	tracerDefaultSecrets bool
+
+	// agentValueRedactor flag is set when the ValueRedactor has been configured by the host agent, and can
+	// be overridden by the agent configuration received during the next handshake.
+	agentValueRedactor bool
+
+	// agentHTTPHeaders and agentDisableSpans flags are set when CollectableHTTPHeaders and DisableSpans respectively
+	// have been configured by the host agent, and can be updated by the agent configuration received later on.
+	agentHTTPHeaders  bool
+	agentDisableSpans bool
 }
 
 // DefaultTracerOptions returns the default set of options to configure a tracer